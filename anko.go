@@ -3,33 +3,55 @@
 package main
 
 import (
-	"bufio"
+	"context"
 	"flag"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"os"
-	"strings"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/mattn/anko/core"
 	"github.com/mattn/anko/env"
 	_ "github.com/mattn/anko/packages"
 	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/repl"
 	"github.com/mattn/anko/vm"
 )
 
 const version = "0.1.8"
 
+// exit codes of the anko command, a script can exit with any code by calling exit(code)
+const (
+	exitCodeReadError  = 2
+	exitCodeParseError = 3
+	exitCodeRunError   = 4
+	exitCodeInterrupt  = 130
+)
+
 var (
-	flagExecute string
-	file        string
-	args        []string
-	e           *env.Env
+	flagExecute    string
+	flagTimeout    time.Duration
+	flagCPUProfile string
+	file           string
+	args           []string
+	e              *env.Env
+	scriptExit     exitHandler
 )
 
 func main() {
 	var exitCode int
 
+	if isCommand(os.Args, "test") {
+		os.Exit(runTestCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+	if isCommand(os.Args, "build") {
+		os.Exit(runBuildCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	parseFlags()
 	setupEnv()
 	if flagExecute != "" || flag.NArg() > 0 {
@@ -41,9 +63,25 @@ func main() {
 	os.Exit(exitCode)
 }
 
+// isCommand returns true if the first argument is the command name and there is no script file with that name,
+// so a script named like a command still runs. A directory with that name, like test, does not hide the command.
+func isCommand(args []string, name string) bool {
+	if len(args) < 2 || args[1] != name {
+		return false
+	}
+	fileInfo, err := os.Stat(name)
+	return err != nil || !fileInfo.Mode().IsRegular()
+}
+
 func parseFlags() {
 	flagVersion := flag.Bool("v", false, "prints out the version and then exits")
 	flag.StringVar(&flagExecute, "e", "", "execute the Anko code")
+	flag.DurationVar(&flagTimeout, "timeout", 0, "stop the script after the duration, zero for no timeout")
+	flag.StringVar(&flagCPUProfile, "cpuprofile", "", "write a pprof profile of the script lines and functions to the file")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: anko [flags] [file.ank or - for stdin] [arguments]\n       anko test [flags] [patterns]\n       anko build [flags] file.ank")
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
 	if *flagVersion {
@@ -62,106 +100,201 @@ func parseFlags() {
 
 func setupEnv() {
 	e = env.NewEnv()
-	e.Define("args", args)
-	core.Import(e)
+	defineEnv(e)
+}
+
+// defineEnv defines args, exit and the core builtins in the new env
+func defineEnv(newEnv *env.Env) {
+	newEnv.Define("args", args)
+	newEnv.Define("exit", scriptExit.exit)
+	core.Import(newEnv)
+}
+
+// exitHandler implements the exit builtin, it saves the exit code and cancels the running script
+type exitHandler struct {
+	mutex  sync.Mutex
+	cancel context.CancelFunc
+	code   int
+	exited bool
+}
+
+// start sets the cancel function of the running script and clears the saved exit code
+func (handler *exitHandler) start(cancel context.CancelFunc) {
+	handler.mutex.Lock()
+	handler.cancel = cancel
+	handler.code = 0
+	handler.exited = false
+	handler.mutex.Unlock()
+}
+
+func (handler *exitHandler) exit(code int64) {
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+	if handler.exited {
+		return
+	}
+	handler.code = int(code)
+	handler.exited = true
+	if handler.cancel != nil {
+		handler.cancel()
+	}
+}
+
+// exitCode returns the exit code and true if exit was called
+func (handler *exitHandler) exitCode() (int, bool) {
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+	return handler.code, handler.exited
 }
 
 func runNonInteractive() int {
 	var source string
-	if flagExecute != "" {
+	var err error
+	filename := file
+	switch {
+	case flagExecute != "":
 		source = flagExecute
-	} else {
-		sourceBytes, err := ioutil.ReadFile(file)
-		if err != nil {
-			fmt.Println("ReadFile error:", err)
-			return 2
-		}
+		filename = "-e"
+	case file == "-":
+		var sourceBytes []byte
+		sourceBytes, err = ioutil.ReadAll(os.Stdin)
+		source = string(sourceBytes)
+		filename = "<stdin>"
+	default:
+		var sourceBytes []byte
+		sourceBytes, err = ioutil.ReadFile(file)
 		source = string(sourceBytes)
 	}
-
-	_, err := vm.Execute(e, nil, source)
 	if err != nil {
-		fmt.Println("Execute error:", err)
-		return 4
+		fmt.Fprintln(os.Stderr, "ReadFile error:", err)
+		return exitCodeReadError
 	}
 
-	return 0
-}
+	// # starts a line comment, so a #! first line is ignored
+	stmt, err := parser.ParseSrc(source)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, scriptError(filename, err))
+		return exitCodeParseError
+	}
 
-func runInteractive() int {
-	var following bool
-	var source string
-	scanner := bufio.NewScanner(os.Stdin)
+	options := &vm.Options{}
+	if flagCPUProfile != "" {
+		options.Profiler = vm.NewProfiler(filename)
+	}
+	if flagExecute == "" && file != "-" {
+		// require is relative to the script directory
+		modules := core.NewModules(core.SearchPaths()...)
+		modules.EnvSetupFunc = defineEnv
+		modules.Options = options
+		modules.Define(e, filepath.Dir(file))
+	}
 
-	parser.EnableErrorVerbose()
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if flagTimeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), flagTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+	defer cancel()
+	scriptExit.start(cancel)
+	defer scriptExit.start(nil)
 
-	for {
-		if following {
-			source += "\n"
-			fmt.Print("  ")
-		} else {
-			fmt.Print("> ")
+	var interrupted int32
+	chanSignal := make(chan os.Signal, 1)
+	signal.Notify(chanSignal, os.Interrupt)
+	defer signal.Stop(chanSignal)
+	go func() {
+		select {
+		case <-chanSignal:
+			atomic.StoreInt32(&interrupted, 1)
+			cancel()
+		case <-ctx.Done():
 		}
+	}()
 
-		if !scanner.Scan() {
-			break
+	_, err = vm.RunContext(ctx, e, options, stmt)
+	if options.Profiler != nil {
+		profileErr := writeProfile(flagCPUProfile, options.Profiler)
+		if profileErr != nil {
+			fmt.Fprintln(os.Stderr, "write profile error:", profileErr)
 		}
-		source += scanner.Text()
-		if source == "" {
-			continue
-		}
-		if source == "quit()" {
-			break
+	}
+	if code, ok := scriptExit.exitCode(); ok {
+		return code
+	}
+	if err != nil {
+		switch {
+		case atomic.LoadInt32(&interrupted) == 1:
+			fmt.Fprintf(os.Stderr, "%v: %v\n", filename, err)
+			return exitCodeInterrupt
+		case err == vm.ErrInterrupt && ctx.Err() == context.DeadlineExceeded:
+			fmt.Fprintf(os.Stderr, "%v: timeout after %v\n", filename, flagTimeout)
+		default:
+			fmt.Fprintln(os.Stderr, scriptError(filename, err))
 		}
+		return exitCodeRunError
+	}
 
-		stmts, err := parser.ParseSrc(source)
-
-		if e, ok := err.(*parser.Error); ok {
-			es := e.Error()
-			if strings.HasPrefix(es, "syntax error: unexpected") {
-				if strings.HasPrefix(es, "syntax error: unexpected $end,") {
-					following = true
-					continue
-				}
-			} else {
-				if e.Pos.Column == len(source) && !e.Fatal {
-					fmt.Fprintln(os.Stderr, e)
-					following = true
-					continue
-				}
-				if e.Error() == "unexpected EOF" {
-					following = true
-					continue
-				}
-			}
-		}
+	return 0
+}
 
-		following = false
-		source = ""
-		var v interface{}
+// writeProfile writes the pprof profile to the file
+func writeProfile(filename string, profiler *vm.Profiler) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	err = profiler.WritePprof(file)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
 
-		if err == nil {
-			v, err = vm.Run(e, nil, stmts)
+// scriptError returns the error message prefixed with the file and the position for parser and VM errors,
+// followed by the stack of VM errors thrown in script functions. The stack positions have no file name
+// as they can be in a required module.
+func scriptError(filename string, err error) string {
+	switch e := err.(type) {
+	case *parser.Error:
+		return fmt.Sprintf("%v:%v:%v: %v", filename, e.Pos.Line, e.Pos.Column, err)
+	case *vm.Error:
+		message := fmt.Sprintf("%v:%v:%v: %v", filename, e.Pos.Line, e.Pos.Column, err)
+		for _, pos := range e.Stack {
+			message += fmt.Sprintf("\n\tat %v:%v", pos.Line, pos.Column)
 		}
-		if err != nil {
-			if e, ok := err.(*vm.Error); ok {
-				fmt.Fprintf(os.Stderr, "%d:%d %s\n", e.Pos.Line, e.Pos.Column, err)
-			} else if e, ok := err.(*parser.Error); ok {
-				fmt.Fprintf(os.Stderr, "%d:%d %s\n", e.Pos.Line, e.Pos.Column, err)
-			} else {
-				fmt.Fprintln(os.Stderr, err)
-			}
-			continue
-		}
-
-		fmt.Printf("%#v\n", v)
+		return message
 	}
+	return fmt.Sprintf("%v: %v", filename, err)
+}
 
-	if err := scanner.Err(); err != nil {
-		if err != io.EOF {
-			fmt.Fprintln(os.Stderr, "ReadString error:", err)
-			return 12
+func runInteractive() int {
+	parser.EnableErrorVerbose()
+
+	r := repl.New(e, os.Stdin, os.Stdout, os.Stderr)
+	r.EnvSetupFunc = defineEnv
+	if repl.IsTerminal(os.Stdin.Fd()) {
+		r.Terminal = true
+		r.RawModeFunc = func() (func() error, error) {
+			return repl.MakeRaw(os.Stdin.Fd())
 		}
+		r.HistoryFile = repl.DefaultHistoryFile()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scriptExit.start(cancel)
+	defer scriptExit.start(nil)
+
+	err := r.Run(ctx)
+	if code, ok := scriptExit.exitCode(); ok {
+		return code
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "ReadString error:", err)
+		return 12
 	}
 
 	return 0
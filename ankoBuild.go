@@ -0,0 +1,58 @@
+// +build !appengine
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mattn/anko/bundle"
+)
+
+// runBuildCommand runs anko build with the arguments after build and returns the exit code
+func runBuildCommand(arguments []string, stdout io.Writer, stderr io.Writer) int {
+	flagSet := flag.NewFlagSet("anko build", flag.ContinueOnError)
+	flagSet.SetOutput(stderr)
+	flagOutput := flagSet.String("o", "", "file name of the executable, defaults to the script file name without extension")
+	flagPackages := flagSet.String("packages", "all", "comma separated package bindings to link in, like fmt,strings,net/http, or all or none")
+	flagAnkoDir := flagSet.String("anko", "", "directory of the anko module source, defaults to the directory from go list")
+	flagWork := flagSet.String("work", "", "directory to generate the main package in and keep, defaults to a temporary directory")
+	flagSet.Usage = func() {
+		fmt.Fprintln(stderr, "usage: anko build [flags] script.ank")
+		flagSet.PrintDefaults()
+	}
+	err := flagSet.Parse(arguments)
+	if err != nil {
+		return 2
+	}
+	if flagSet.NArg() != 1 {
+		flagSet.Usage()
+		return 2
+	}
+
+	options := &bundle.Options{
+		Output:  *flagOutput,
+		AnkoDir: *flagAnkoDir,
+		WorkDir: *flagWork,
+		Warnf: func(format string, a ...interface{}) {
+			fmt.Fprintf(stderr, "warning: "+format+"\n", a...)
+		},
+	}
+	switch *flagPackages {
+	case "all":
+	case "none", "":
+		options.Packages = []string{}
+	default:
+		options.Packages = strings.Split(*flagPackages, ",")
+	}
+
+	err = bundle.Build(context.Background(), flagSet.Arg(0), options)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,134 @@
+// +build !appengine
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mattn/anko/anktest"
+	"github.com/mattn/anko/core"
+	"github.com/mattn/anko/cover"
+	"github.com/mattn/anko/env"
+)
+
+// runTestCommand runs anko test with the arguments after test and returns the exit code
+func runTestCommand(arguments []string, stdout io.Writer, stderr io.Writer) int {
+	flagSet := flag.NewFlagSet("anko test", flag.ContinueOnError)
+	flagSet.SetOutput(stderr)
+	flagJSON := flagSet.Bool("json", false, "report results as go test -json events")
+	flagTAP := flagSet.Bool("tap", false, "report results in TAP version 13 format")
+	flagVerbose := flagSet.Bool("v", false, "report all tests, not only failed tests")
+	flagRun := flagSet.String("run", "", "run only the tests matching the regular expression")
+	flagTimeout := flagSet.Duration("timeout", 0, "timeout for each test file, zero for no timeout")
+	flagCover := flagSet.Bool("cover", false, "report the coverage of the scripts loaded by the tests")
+	flagCoverProfile := flagSet.String("coverprofile", "", "write a Go cover profile of the statement counts to the file, implies -cover")
+	flagCoverHTML := flagSet.String("coverhtml", "", "write an HTML coverage report with the annotated source to the file, implies -cover")
+	flagSet.Usage = func() {
+		fmt.Fprintln(stderr, "usage: anko test [flags] [files, directories or directory/... patterns]")
+		flagSet.PrintDefaults()
+	}
+	err := flagSet.Parse(arguments)
+	if err != nil {
+		return 2
+	}
+	if *flagJSON && *flagTAP {
+		fmt.Fprintln(stderr, "only one of -json and -tap can be used")
+		return 2
+	}
+
+	patterns := flagSet.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+	files, err := anktest.FindFiles(patterns)
+	if err != nil {
+		fmt.Fprintln(stderr, "FindFiles error:", err)
+		return 2
+	}
+	if len(files) == 0 {
+		fmt.Fprintln(stderr, "no test files")
+		return 0
+	}
+
+	options := &anktest.Options{
+		Run:     *flagRun,
+		Timeout: *flagTimeout,
+		EnvSetupFunc: func(e *env.Env) {
+			e.Define("args", []string{})
+			core.Import(e)
+		},
+	}
+	if *flagCover || *flagCoverProfile != "" || *flagCoverHTML != "" {
+		options.Coverage = cover.NewProfile()
+	}
+	results, err := anktest.Run(context.Background(), files, options)
+	if err != nil {
+		fmt.Fprintln(stderr, "Run error:", err)
+		return 2
+	}
+
+	switch {
+	case *flagJSON:
+		err = anktest.WriteJSON(stdout, results)
+	case *flagTAP:
+		err = anktest.WriteTAP(stdout, results)
+	default:
+		err = anktest.WriteText(stdout, results, *flagVerbose)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, "Write error:", err)
+		return 2
+	}
+
+	if options.Coverage != nil {
+		err = writeCoverage(stdout, options.Coverage, !*flagJSON && !*flagTAP, *flagCoverProfile, *flagCoverHTML)
+		if err != nil {
+			fmt.Fprintln(stderr, "Write coverage error:", err)
+			return 2
+		}
+	}
+
+	for _, result := range results {
+		if result.Failed() {
+			return 1
+		}
+	}
+	return 0
+}
+
+// writeCoverage writes the coverage text report to stdout if text is true, and the profile and HTML files if not empty
+func writeCoverage(stdout io.Writer, profile *cover.Profile, text bool, profileFile string, htmlFile string) error {
+	if text {
+		err := cover.WriteText(stdout, profile)
+		if err != nil {
+			return err
+		}
+	}
+
+	writeFile := func(filename string, write func(io.Writer, *cover.Profile) error) error {
+		file, err := os.Create(filename)
+		if err != nil {
+			return err
+		}
+		err = write(file, profile)
+		if err != nil {
+			file.Close()
+			return err
+		}
+		return file.Close()
+	}
+	if profileFile != "" {
+		err := writeFile(profileFile, cover.WriteGoProfile)
+		if err != nil {
+			return err
+		}
+	}
+	if htmlFile != "" {
+		return writeFile(htmlFile, cover.WriteHTML)
+	}
+	return nil
+}
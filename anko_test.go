@@ -5,6 +5,7 @@ package main
 import (
 	"bufio"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
@@ -13,6 +14,9 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/vm"
 )
 
 var logger *log.Logger
@@ -37,8 +41,8 @@ func TestRunNonInteractiveFile(t *testing.T) {
 	file = filepath.Join(testDir, "broken.ank")
 	exitCode = runNonInteractive()
 	os.Args = []string{os.Args[0]}
-	if exitCode != 4 {
-		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 4)
+	if exitCode != 3 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 3)
 	}
 
 	file = filepath.Join(testDir, "test.ank")
@@ -66,9 +70,158 @@ func TestRunNonInteractiveExecute(t *testing.T) {
 		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 4)
 	}
 
+	flagExecute = "1 +"
+	exitCode = runNonInteractive()
+	if exitCode != 3 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 3)
+	}
+
+	flagExecute = "#!/usr/bin/env anko\nexit(len(args) + 5)\nexit(1)"
+	exitCode = runNonInteractive()
+	if exitCode != 5 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 5)
+	}
+
+	flagExecute = "for { try { exit(6) } catch { } }"
+	exitCode = runNonInteractive()
+	if exitCode != 6 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 6)
+	}
+
+	flagExecute = "for { }"
+	flagTimeout = 10 * time.Millisecond
+	exitCode = runNonInteractive()
+	if exitCode != 4 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 4)
+	}
+	flagTimeout = 0
+
+	flagExecute = "1 + 1"
+	exitCode = runNonInteractive()
+	if exitCode != 0 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 0)
+	}
+
 	flagExecute = ""
 }
 
+func TestRunNonInteractiveCPUProfile(t *testing.T) {
+	setupEnv()
+	dir, err := ioutil.TempDir("", "anko")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(dir)
+
+	flagCPUProfile = filepath.Join(dir, "cpu.pprof")
+	flagExecute = "func f(a) { return a + 1 }\nf(1)"
+	exitCode := runNonInteractive()
+	flagCPUProfile = ""
+	flagExecute = ""
+	if exitCode != 0 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 0)
+	}
+
+	fileInfo, err := os.Stat(filepath.Join(dir, "cpu.pprof"))
+	if err != nil {
+		t.Fatal("Stat error:", err)
+	}
+	if fileInfo.Size() == 0 {
+		t.Error("profile file is empty")
+	}
+}
+
+func TestRunNonInteractiveStdin(t *testing.T) {
+	setupEnv()
+
+	realStdin := os.Stdin
+	defer func() { os.Stdin = realStdin }()
+
+	readFromIn, writeToIn, err := os.Pipe()
+	if err != nil {
+		t.Fatal("Pipe error:", err)
+	}
+	os.Stdin = readFromIn
+	_, err = writeToIn.WriteString("#!/usr/bin/env anko\na = 1\nexit(a + 6)\n")
+	if err != nil {
+		t.Fatal("Stdin WriteString error:", err)
+	}
+	writeToIn.Close()
+
+	file = "-"
+	exitCode := runNonInteractive()
+	if exitCode != 7 {
+		t.Fatalf("exitCode - received: %v - expected: %v", exitCode, 7)
+	}
+
+	file = ""
+}
+
+func TestIsCommand(t *testing.T) {
+	dir, err := ioutil.TempDir("", "anko")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(dir)
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal("Getwd error:", err)
+	}
+	err = os.Chdir(dir)
+	if err != nil {
+		t.Fatal("Chdir error:", err)
+	}
+	defer os.Chdir(workingDir)
+
+	if !isCommand([]string{"anko", "test", "./..."}, "test") {
+		t.Errorf("isCommand test - received: %v - expected: %v", false, true)
+	}
+	if isCommand([]string{"anko", "script.ank"}, "test") || isCommand([]string{"anko"}, "test") {
+		t.Errorf("isCommand test - received: %v - expected: %v", true, false)
+	}
+
+	// a directory named test does not hide the command
+	err = os.Mkdir(filepath.Join(dir, "test"), 0755)
+	if err != nil {
+		t.Fatal("Mkdir error:", err)
+	}
+	if !isCommand([]string{"anko", "test", "./..."}, "test") {
+		t.Errorf("isCommand test - received: %v - expected: %v", false, true)
+	}
+	err = os.Remove(filepath.Join(dir, "test"))
+	if err != nil {
+		t.Fatal("Remove error:", err)
+	}
+
+	// a script file named test is run
+	err = ioutil.WriteFile(filepath.Join(dir, "test"), []byte("1"), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+	if isCommand([]string{"anko", "test"}, "test") {
+		t.Errorf("isCommand test - received: %v - expected: %v", true, false)
+	}
+}
+
+func TestScriptError(t *testing.T) {
+	tests := []struct {
+		script   string
+		expected string
+	}{
+		{script: "a = 1\n1 +", expected: "script.ank:2:4: syntax error"},
+		{script: "a = 1\n  b", expected: "script.ank:2:3: undefined symbol 'b'"},
+		{script: "throw \"error\"", expected: "script.ank:1:1: error"},
+		{script: "func a() {\n\tthrow \"error\"\n}\na()", expected: "script.ank:1:1: error\n\tat 2:2\n\tat 4:1"},
+	}
+	for _, test := range tests {
+		_, err := vm.Execute(env.NewEnv(), nil, test.script)
+		message := scriptError("script.ank", err)
+		if message != test.expected {
+			t.Errorf("scriptError - received: %v - expected: %v - script: %v", message, test.expected, test.script)
+		}
+	}
+}
+
 type testInteractive struct {
 	runLines   []string
 	runOutputs []string
@@ -86,8 +239,8 @@ func TestRunInteractive(t *testing.T) {
 		{runLines: []string{"1 + 1"}, runOutputs: []string{"2"}},
 		{runLines: []string{"a = 1", "b = 2", "a + b"}, runOutputs: []string{"1", "2", "3"}},
 		{runLines: []string{"a = 1", "if a == 1 {", "b = 1", "b = 2", "}", "a"}, runOutputs: []string{"1", "", "", "", "2", "1"}},
-		{runLines: []string{"a = 1", "for i = 0; i < 2; i++ {", "a++", "}", "a"}, runOutputs: []string{"1", "", "", "<nil>", "3"}},
-		{runLines: []string{"1 + 1", "// comment 1", "2 + 2 // comment 2", "// 3 + 3"}, runOutputs: []string{"2", "<nil>", "4", "<nil>"}},
+		{runLines: []string{"a = 1", "for i = 0; i < 2; i++ {", "a++", "}", "a"}, runOutputs: []string{"1", "", "", "nil", "3"}},
+		{runLines: []string{"1 + 1", "// comment 1", "2 + 2 // comment 2", "// 3 + 3"}, runOutputs: []string{"2", "nil", "4", "nil"}},
 	}
 	runInteractiveTests(t, tests)
 }
@@ -0,0 +1,305 @@
+// Package anktest implements the testing module for anko scripts and the runner used by anko test.
+//
+// Test files are named *_test.ank. Every top level function of a test file named Test followed by
+// an upper case letter, number or underscore is run as a test, with the test state as its argument:
+//
+//	func TestAdd(t) {
+//		t.equal(2, 1 + 1)
+//		t.run("negative", func(t) {
+//			t.equal(-2, -1 + -1)
+//		})
+//	}
+package anktest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattn/anko/vm"
+)
+
+// Result is the result of a test.
+type Result struct {
+	Name     string
+	Failed   bool
+	Skipped  bool
+	Elapsed  time.Duration
+	Output   []string
+	Subtests []*Result
+}
+
+// T is the test state passed to script test functions.
+// Scripts use the methods with lower case names, for example t.equal and t.ok.
+type T struct {
+	mutex   sync.Mutex
+	result  *Result
+	filter  func(name string) bool
+	stopped bool
+}
+
+// errStop is panicked to stop the running test by Skip and FailNow
+var errStop = errors.New("test stopped")
+
+func init() {
+	vm.RegisterMembers(reflect.TypeOf(&T{}), &vm.MemberOptions{LowerCase: true})
+}
+
+// newT returns a new T for the test name
+func newT(name string, filter func(name string) bool) *T {
+	return &T{result: &Result{Name: name}, filter: filter}
+}
+
+// Name returns the name of the test.
+func (t *T) Name() string {
+	return t.result.Name
+}
+
+// Log adds the arguments to the test output.
+func (t *T) Log(args ...interface{}) {
+	t.mutex.Lock()
+	t.result.Output = append(t.result.Output, strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+	t.mutex.Unlock()
+}
+
+// Fail marks the test as failed and adds the arguments to the test output.
+// The test continues to run.
+func (t *T) Fail(args ...interface{}) {
+	t.mutex.Lock()
+	t.result.Failed = true
+	if len(args) > 0 {
+		t.result.Output = append(t.result.Output, strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+	}
+	t.mutex.Unlock()
+}
+
+// FailNow marks the test as failed, adds the arguments to the test output and stops the test.
+func (t *T) FailNow(args ...interface{}) {
+	t.Fail(args...)
+	t.stop()
+}
+
+// Failed returns true if the test has failed.
+func (t *T) Failed() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.result.Failed
+}
+
+// Skip marks the test as skipped, adds the arguments to the test output and stops the test.
+func (t *T) Skip(args ...interface{}) {
+	t.mutex.Lock()
+	t.result.Skipped = true
+	if len(args) > 0 {
+		t.result.Output = append(t.result.Output, strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+	}
+	t.mutex.Unlock()
+	t.stop()
+}
+
+// Ok checks that value is true. Optional message arguments are added to the failure output.
+func (t *T) Ok(value interface{}, message ...interface{}) bool {
+	if b, ok := value.(bool); ok && b {
+		return true
+	}
+	t.Fail(failMessage(fmt.Sprintf("ok - received: %#v", value), message))
+	return false
+}
+
+// Equal checks that actual is equal to expected. Optional message arguments are added to the failure output.
+func (t *T) Equal(expected interface{}, actual interface{}, message ...interface{}) bool {
+	if equal(expected, actual) {
+		return true
+	}
+	t.Fail(failMessage(fmt.Sprintf("equal - received: %#v - expected: %#v", actual, expected), message))
+	return false
+}
+
+// NotEqual checks that actual is not equal to expected. Optional message arguments are added to the failure output.
+func (t *T) NotEqual(expected interface{}, actual interface{}, message ...interface{}) bool {
+	if !equal(expected, actual) {
+		return true
+	}
+	t.Fail(failMessage(fmt.Sprintf("notEqual - received: %#v", actual), message))
+	return false
+}
+
+// Throws checks that calling function fn throws an error. Returns the error message or an empty string.
+// If the first message argument is a string, it must be part of the error message.
+func (t *T) Throws(fn func(), message ...interface{}) (errMessage string) {
+	defer func() {
+		recoverInterface := recover()
+		if recoverInterface == nil {
+			t.Fail(failMessage("throws - no error thrown", message))
+			return
+		}
+		if recoverInterface == errStop || t.isStopped() {
+			panic(recoverInterface)
+		}
+		errMessage = fmt.Sprint(recoverInterface)
+		if len(message) > 0 {
+			if expected, ok := message[0].(string); ok && !strings.Contains(errMessage, expected) {
+				t.Fail(failMessage(fmt.Sprintf("throws - received: %v - expected: %v", errMessage, expected), message[1:]))
+			}
+		}
+	}()
+
+	fn()
+	return ""
+}
+
+// Run runs function fn as a subtest of t named name. Returns true if the subtest did not fail.
+func (t *T) Run(name string, fn func(*T)) bool {
+	subT := newT(t.result.Name+"/"+strings.Replace(name, " ", "_", -1), t.filter)
+	if t.filter != nil && !t.filter(subT.result.Name) {
+		return true
+	}
+
+	subT.run(func() error {
+		fn(subT)
+		return nil
+	})
+
+	t.mutex.Lock()
+	t.result.Subtests = append(t.result.Subtests, subT.result)
+	if subT.result.Failed {
+		t.result.Failed = true
+	}
+	t.mutex.Unlock()
+
+	return !subT.result.Failed
+}
+
+// run runs fn, recording the elapsed time and any error or panic as a failure
+func (t *T) run(fn func() error) {
+	start := time.Now()
+	defer func() {
+		recoverInterface := recover()
+		if recoverInterface != nil && recoverInterface != errStop && !t.isStopped() {
+			if err, ok := recoverInterface.(error); ok {
+				t.Fail(errorMessage(err))
+			} else {
+				t.Fail(fmt.Sprint(recoverInterface))
+			}
+		}
+		t.result.Elapsed = time.Since(start)
+	}()
+
+	err := fn()
+	if err != nil && !t.isStopped() {
+		t.Fail(errorMessage(err))
+	}
+}
+
+// stop stops the running test
+func (t *T) stop() {
+	t.mutex.Lock()
+	t.stopped = true
+	t.mutex.Unlock()
+	panic(errStop)
+}
+
+// isStopped returns true if the test was stopped by Skip or FailNow.
+// Errors from stopping can get wrapped by the VM, so the state is checked instead of the error.
+func (t *T) isStopped() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.stopped
+}
+
+// failMessage returns the failure message with the optional message arguments
+func failMessage(failure string, message []interface{}) string {
+	if len(message) == 0 {
+		return failure
+	}
+	return failure + " - " + strings.TrimSuffix(fmt.Sprintln(message...), "\n")
+}
+
+// errorMessage returns the error message with the position for VM errors
+func errorMessage(err error) string {
+	if e, ok := err.(*vm.Error); ok {
+		return fmt.Sprintf("%d:%d: %v", e.Pos.Line, e.Pos.Column, e.Message)
+	}
+	return err.Error()
+}
+
+// equal returns true if expected and actual are deeply equal, comparing numbers by value
+func equal(expected interface{}, actual interface{}) bool {
+	if reflect.DeepEqual(expected, actual) {
+		return true
+	}
+
+	expectedV := reflect.ValueOf(expected)
+	actualV := reflect.ValueOf(actual)
+	if !expectedV.IsValid() || !actualV.IsValid() {
+		return false
+	}
+
+	switch {
+	case isNumber(expectedV) && isNumber(actualV):
+		if isFloat(expectedV) || isFloat(actualV) {
+			return toFloat64(expectedV) == toFloat64(actualV)
+		}
+		return fmt.Sprint(expectedV.Interface()) == fmt.Sprint(actualV.Interface())
+	case (expectedV.Kind() == reflect.Slice || expectedV.Kind() == reflect.Array) &&
+		(actualV.Kind() == reflect.Slice || actualV.Kind() == reflect.Array):
+		if expectedV.Len() != actualV.Len() {
+			return false
+		}
+		for i := 0; i < expectedV.Len(); i++ {
+			if !equal(expectedV.Index(i).Interface(), actualV.Index(i).Interface()) {
+				return false
+			}
+		}
+		return true
+	case expectedV.Kind() == reflect.Map && actualV.Kind() == reflect.Map:
+		if expectedV.Len() != actualV.Len() {
+			return false
+		}
+		for _, key := range expectedV.MapKeys() {
+			actualValue := mapIndex(actualV, key.Interface())
+			if !actualValue.IsValid() || !equal(expectedV.MapIndex(key).Interface(), actualValue.Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// mapIndex returns the value of the key in map m that is equal to key
+func mapIndex(m reflect.Value, key interface{}) reflect.Value {
+	for _, mapKey := range m.MapKeys() {
+		if equal(key, mapKey.Interface()) {
+			return m.MapIndex(mapKey)
+		}
+	}
+	return reflect.Value{}
+}
+
+func isNumber(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return isFloat(v)
+}
+
+func isFloat(v reflect.Value) bool {
+	return v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64
+}
+
+func toFloat64(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint())
+	}
+	return float64(v.Int())
+}
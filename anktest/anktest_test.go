@@ -0,0 +1,269 @@
+package anktest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mattn/anko/cover"
+)
+
+func TestFindFiles(t *testing.T) {
+	tests := []struct {
+		patterns []string
+		files    []string
+	}{
+		{patterns: []string{"testdata"}, files: []string{"testdata/pass_test.ank"}},
+		{patterns: []string{"testdata/..."}, files: []string{"testdata/pass_test.ank", "testdata/sub/broken_test.ank", "testdata/sub/fail_test.ank"}},
+		{patterns: []string{"testdata/sub/fail_test.ank", "testdata/sub"}, files: []string{"testdata/sub/broken_test.ank", "testdata/sub/fail_test.ank"}},
+		{patterns: []string{"..."}, files: []string{}},
+	}
+
+	for _, test := range tests {
+		files, err := FindFiles(test.patterns)
+		if err != nil {
+			t.Errorf("FindFiles error - received: %v - expected: %v - patterns: %v", err, nil, test.patterns)
+			continue
+		}
+		if !reflect.DeepEqual(files, test.files) {
+			t.Errorf("FindFiles - received: %#v - expected: %#v - patterns: %v", files, test.files, test.patterns)
+		}
+	}
+
+	_, err := FindFiles([]string{"testdata/not-found"})
+	if err == nil {
+		t.Errorf("FindFiles error - received: %v - expected: %v", err, "not found error")
+	}
+}
+
+func TestRun(t *testing.T) {
+	results, err := Run(context.Background(), []string{"testdata/pass_test.ank"}, nil)
+	if err != nil {
+		t.Fatal("Run error:", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("Run - received: %v results - expected: %v results", len(results), 1)
+	}
+	result := results[0]
+	if result.Err != nil || result.Failed() {
+		var buffer bytes.Buffer
+		WriteText(&buffer, results, true)
+		t.Fatalf("Run failed - error: %v - output:\n%v", result.Err, buffer.String())
+	}
+
+	expected := map[string]string{"TestAdd": "PASS", "TestSkip": "SKIP", "TestSubtests": "PASS", "TestThrows": "PASS"}
+	received := make(map[string]string)
+	for _, test := range result.Tests {
+		received[test.Name] = textStatus(test)
+	}
+	if !reflect.DeepEqual(received, expected) {
+		t.Errorf("Run tests - received: %v - expected: %v", received, expected)
+	}
+	if len(result.Tests) > 2 && len(result.Tests[2].Subtests) != 2 {
+		t.Errorf("Run subtests - received: %v - expected: %v", len(result.Tests[2].Subtests), 2)
+	}
+
+	results, err = Run(context.Background(), []string{"testdata/sub/fail_test.ank", "testdata/sub/broken_test.ank"}, nil)
+	if err != nil {
+		t.Fatal("Run error:", err)
+	}
+	if !results[0].Failed() || results[0].Err != nil || !results[1].Failed() || results[1].Err == nil {
+		t.Fatalf("Run - received: %#v, %#v - expected failures", results[0], results[1])
+	}
+
+	var buffer bytes.Buffer
+	err = WriteText(&buffer, results, false)
+	if err != nil {
+		t.Fatal("WriteText error:", err)
+	}
+	output := buffer.String()
+	for _, expected := range []string{
+		"--- FAIL: TestFail (",
+		"    start\n    equal - received: 2 - expected: 1\n    ok - received: false - message\n    throws - no error thrown\n    throws - received: a - expected: b\n    end\n",
+		"--- FAIL: TestError (",
+		"    10:1: undefined symbol 'b'\n",
+		"--- FAIL: TestFailNow (",
+		"    stop\n--- FAIL: TestSubtestFail",
+		"    --- FAIL: TestSubtestFail/fail (",
+		"FAIL\ttestdata/sub/fail_test.ank\t",
+		"testdata/sub/broken_test.ank:",
+		"FAIL\ttestdata/sub/broken_test.ank [setup failed]\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("WriteText - expected: %q - in output:\n%v", expected, output)
+		}
+	}
+	if strings.Contains(output, "TestPass") || strings.Contains(output, "not reached") {
+		t.Errorf("WriteText - unexpected output:\n%v", output)
+	}
+
+	results, err = Run(context.Background(), []string{"testdata/pass_test.ank", "testdata/sub/fail_test.ank"}, &Options{Run: "Sub/^b$|fail"})
+	if err != nil {
+		t.Fatal("Run error:", err)
+	}
+	buffer.Reset()
+	err = WriteTAP(&buffer, results)
+	if err != nil {
+		t.Fatal("WriteTAP error:", err)
+	}
+	expectedTAP := `TAP version 13
+ok 1 - testdata/pass_test.ank TestSubtests/b
+ok 2 - testdata/pass_test.ank TestSubtests
+not ok 3 - testdata/sub/fail_test.ank TestSubtestFail/fail
+# fail
+not ok 4 - testdata/sub/fail_test.ank TestSubtestFail
+1..4
+`
+	if buffer.String() != expectedTAP {
+		t.Errorf("WriteTAP - received:\n%v - expected:\n%v", buffer.String(), expectedTAP)
+	}
+
+	_, err = Run(context.Background(), nil, &Options{Run: "("})
+	if err == nil {
+		t.Errorf("Run error - received: %v - expected: %v", err, "invalid run pattern")
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	results, err := Run(context.Background(), []string{"testdata/pass_test.ank", "testdata/sub/fail_test.ank"}, &Options{Run: "TestSkip|TestSubtestFail/fail"})
+	if err != nil {
+		t.Fatal("Run error:", err)
+	}
+
+	var buffer bytes.Buffer
+	err = WriteJSON(&buffer, results)
+	if err != nil {
+		t.Fatal("WriteJSON error:", err)
+	}
+
+	var received []string
+	decoder := json.NewDecoder(&buffer)
+	for decoder.More() {
+		var event testEvent
+		err = decoder.Decode(&event)
+		if err != nil {
+			t.Fatal("Decode error:", err)
+		}
+		if event.Action == "output" {
+			continue
+		}
+		received = append(received, event.Action+" "+event.Package+" "+event.Test)
+	}
+
+	expected := []string{
+		"run testdata/pass_test.ank TestSkip",
+		"skip testdata/pass_test.ank TestSkip",
+		"pass testdata/pass_test.ank ",
+		"run testdata/sub/fail_test.ank TestSubtestFail",
+		"run testdata/sub/fail_test.ank TestSubtestFail/fail",
+		"fail testdata/sub/fail_test.ank TestSubtestFail/fail",
+		"fail testdata/sub/fail_test.ank TestSubtestFail",
+		"fail testdata/sub/fail_test.ank ",
+	}
+	if !reflect.DeepEqual(received, expected) {
+		t.Errorf("WriteJSON - received: %#v - expected: %#v", received, expected)
+	}
+}
+
+func TestRunCoverage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "anktest")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(dir)
+	testFile := filepath.Join(dir, "sign_test.ank")
+	err = ioutil.WriteFile(testFile, []byte(`load("testdata/cover.ank")
+
+func TestSign(t) {
+	t.equal(1, sign(2))
+	t.equal(0, sign(0))
+}
+`), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+
+	profile := cover.NewProfile()
+	results, err := Run(context.Background(), []string{testFile}, &Options{Coverage: profile})
+	if err != nil {
+		t.Fatal("Run error:", err)
+	}
+	if results[0].Failed() {
+		var buffer bytes.Buffer
+		WriteText(&buffer, results, true)
+		t.Fatalf("Run failed - error: %v - output:\n%v", results[0].Err, buffer.String())
+	}
+
+	var buffer bytes.Buffer
+	err = cover.WriteText(&buffer, profile)
+	if err != nil {
+		t.Fatal("WriteText error:", err)
+	}
+	expected := `testdata/cover.ank:2:2: if else if 1 not taken
+testdata/cover.ank:5:3: statement not run
+testdata/cover.ank	statements 80.0% (4/5)	branches 66.7% (2/3)
+total	statements 80.0% (4/5)	branches 66.7% (2/3)
+`
+	if buffer.String() != expected {
+		t.Errorf("coverage - received:\n%v - expected:\n%v", buffer.String(), expected)
+	}
+}
+
+func TestRunCoverageRequire(t *testing.T) {
+	dir, err := ioutil.TempDir("", "anktest")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(dir)
+	source, err := ioutil.ReadFile(filepath.Join("testdata", "cover.ank"))
+	if err != nil {
+		t.Fatal("ReadFile error:", err)
+	}
+	moduleFile := filepath.Join(dir, "sign.ank")
+	err = ioutil.WriteFile(moduleFile, source, 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+	testFile := filepath.Join(dir, "sign_test.ank")
+	err = ioutil.WriteFile(testFile, []byte(`lib = require("sign")
+
+func TestSign(t) {
+	t.equal(1, lib.sign(2))
+	t.equal(0, lib.sign(0))
+}
+`), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+
+	profile := cover.NewProfile()
+	results, err := Run(context.Background(), []string{testFile}, &Options{Coverage: profile})
+	if err != nil {
+		t.Fatal("Run error:", err)
+	}
+	if results[0].Failed() {
+		var buffer bytes.Buffer
+		WriteText(&buffer, results, true)
+		t.Fatalf("Run failed - error: %v - output:\n%v", results[0].Err, buffer.String())
+	}
+
+	var buffer bytes.Buffer
+	err = cover.WriteText(&buffer, profile)
+	if err != nil {
+		t.Fatal("WriteText error:", err)
+	}
+	expected := moduleFile + `:2:2: if else if 1 not taken
+` + moduleFile + `:5:3: statement not run
+` + moduleFile + `	statements 80.0% (4/5)	branches 66.7% (2/3)
+total	statements 80.0% (4/5)	branches 66.7% (2/3)
+`
+	if buffer.String() != expected {
+		t.Errorf("coverage - received:\n%v - expected:\n%v", buffer.String(), expected)
+	}
+}
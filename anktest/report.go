@@ -0,0 +1,255 @@
+package anktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// testEvent is a go test -json event
+type testEvent struct {
+	Time    time.Time
+	Action  string
+	Package string   `json:",omitempty"`
+	Test    string   `json:",omitempty"`
+	Elapsed *float64 `json:",omitempty"`
+	Output  string   `json:",omitempty"`
+}
+
+// WriteText writes the results in the go test output format.
+// When verbose is false, only failed tests are written.
+func WriteText(w io.Writer, results []*FileResult, verbose bool) error {
+	for _, result := range results {
+		var err error
+		if result.Err != nil {
+			_, err = fmt.Fprintf(w, "%v\nFAIL\t%v [setup failed]\n", result.Err, result.File)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, test := range result.Tests {
+			err = writeTextResult(w, test, verbose, "")
+			if err != nil {
+				return err
+			}
+		}
+
+		if result.Failed() {
+			_, err = fmt.Fprintf(w, "FAIL\t%v\t%.3fs\n", result.File, result.Elapsed.Seconds())
+		} else {
+			_, err = fmt.Fprintf(w, "ok  \t%v\t%.3fs\n", result.File, result.Elapsed.Seconds())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeTextResult writes the test result and its subtests in the go test output format
+func writeTextResult(w io.Writer, test *Result, verbose bool, indent string) error {
+	if !verbose && !test.Failed {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(w, "%v--- %v: %v (%.2fs)\n", indent, textStatus(test), test.Name, test.Elapsed.Seconds())
+	if err != nil {
+		return err
+	}
+	for _, line := range test.Output {
+		_, err = fmt.Fprintf(w, "%v    %v\n", indent, line)
+		if err != nil {
+			return err
+		}
+	}
+	for _, subtest := range test.Subtests {
+		err = writeTextResult(w, subtest, verbose, indent+"    ")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// textStatus returns the go test status of the test result
+func textStatus(test *Result) string {
+	switch {
+	case test.Failed:
+		return "FAIL"
+	case test.Skipped:
+		return "SKIP"
+	}
+	return "PASS"
+}
+
+// WriteTAP writes the results in the Test Anything Protocol version 13 format.
+// Each test and subtest is a test point named by its file and test name.
+func WriteTAP(w io.Writer, results []*FileResult) error {
+	_, err := fmt.Fprintln(w, "TAP version 13")
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	for _, result := range results {
+		if result.Err != nil {
+			count++
+			_, err = fmt.Fprintf(w, "not ok %d - %v\n", count, result.File)
+			if err != nil {
+				return err
+			}
+			err = writeTAPDiagnostics(w, []string{result.Err.Error()})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, test := range result.Tests {
+			err = writeTAPResult(w, result.File, test, &count)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = fmt.Fprintf(w, "1..%d\n", count)
+	return err
+}
+
+// writeTAPResult writes the subtests and then the test as TAP test points
+func writeTAPResult(w io.Writer, file string, test *Result, count *int) error {
+	for _, subtest := range test.Subtests {
+		err := writeTAPResult(w, file, subtest, count)
+		if err != nil {
+			return err
+		}
+	}
+
+	*count++
+	var err error
+	switch {
+	case test.Failed:
+		_, err = fmt.Fprintf(w, "not ok %d - %v %v\n", *count, file, test.Name)
+	case test.Skipped:
+		_, err = fmt.Fprintln(w, strings.TrimSpace(fmt.Sprintf("ok %d - %v %v # SKIP %v", *count, file, test.Name, strings.Join(test.Output, " "))))
+		return err
+	default:
+		_, err = fmt.Fprintf(w, "ok %d - %v %v\n", *count, file, test.Name)
+	}
+	if err != nil {
+		return err
+	}
+	return writeTAPDiagnostics(w, test.Output)
+}
+
+// writeTAPDiagnostics writes lines as TAP diagnostics
+func writeTAPDiagnostics(w io.Writer, lines []string) error {
+	for _, line := range lines {
+		for _, subLine := range strings.Split(line, "\n") {
+			_, err := fmt.Fprintf(w, "# %v\n", subLine)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the results as go test -json events, using the file names as package names.
+func WriteJSON(w io.Writer, results []*FileResult) error {
+	encoder := json.NewEncoder(w)
+	now := time.Now()
+
+	for _, result := range results {
+		event := func(action string, test string, elapsed *time.Duration, output string) error {
+			testEvent := testEvent{Time: now, Action: action, Package: result.File, Test: test, Output: output}
+			if elapsed != nil {
+				seconds := elapsed.Seconds()
+				testEvent.Elapsed = &seconds
+			}
+			return encoder.Encode(testEvent)
+		}
+
+		if result.Err != nil {
+			err := event("output", "", nil, result.Err.Error()+"\n")
+			if err != nil {
+				return err
+			}
+			err = event("output", "", nil, "FAIL\t"+result.File+" [setup failed]\n")
+			if err != nil {
+				return err
+			}
+			err = event("fail", "", &result.Elapsed, "")
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		var writeTest func(test *Result, indent string) error
+		writeTest = func(test *Result, indent string) error {
+			err := event("run", test.Name, nil, "")
+			if err != nil {
+				return err
+			}
+			err = event("output", test.Name, nil, "=== RUN   "+test.Name+"\n")
+			if err != nil {
+				return err
+			}
+			for _, line := range test.Output {
+				err = event("output", test.Name, nil, indent+"    "+line+"\n")
+				if err != nil {
+					return err
+				}
+			}
+			for _, subtest := range test.Subtests {
+				err = writeTest(subtest, indent+"    ")
+				if err != nil {
+					return err
+				}
+			}
+			status := textStatus(test)
+			err = event("output", test.Name, nil, fmt.Sprintf("%v--- %v: %v (%.2fs)\n", indent, status, test.Name, test.Elapsed.Seconds()))
+			if err != nil {
+				return err
+			}
+			return event(strings.ToLower(status), test.Name, &test.Elapsed, "")
+		}
+
+		for _, test := range result.Tests {
+			err := writeTest(test, "")
+			if err != nil {
+				return err
+			}
+		}
+
+		var err error
+		if result.Failed() {
+			err = event("output", "", nil, "FAIL\n")
+			if err == nil {
+				err = event("output", "", nil, fmt.Sprintf("FAIL\t%v\t%.3fs\n", result.File, result.Elapsed.Seconds()))
+			}
+			if err == nil {
+				err = event("fail", "", &result.Elapsed, "")
+			}
+		} else {
+			err = event("output", "", nil, "PASS\n")
+			if err == nil {
+				err = event("output", "", nil, fmt.Sprintf("ok  \t%v\t%.3fs\n", result.File, result.Elapsed.Seconds()))
+			}
+			if err == nil {
+				err = event("pass", "", &result.Elapsed, "")
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
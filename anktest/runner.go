@@ -0,0 +1,302 @@
+package anktest
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mattn/anko/core"
+	"github.com/mattn/anko/cover"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/vm"
+)
+
+// Options are the options to run test files.
+type Options struct {
+	// EnvSetupFunc sets up the env of each test file. Defaults to core.Import.
+	EnvSetupFunc func(*env.Env)
+	// Run selects the tests to run like go test -run, a slash separated regular expression per test level.
+	Run string
+	// Timeout is the timeout for running each test file. Zero is no timeout.
+	Timeout time.Duration
+	// Coverage collects the coverage of the scripts the test files load if not nil.
+	// The test files themselves are not covered.
+	Coverage *cover.Profile
+}
+
+// FileResult is the result of running a test file.
+type FileResult struct {
+	File    string
+	Err     error
+	Elapsed time.Duration
+	Tests   []*Result
+}
+
+// testFuncType is the type script test functions are converted to
+var testFuncType = reflect.TypeOf((func(context.Context, *T) error)(nil))
+
+// Failed returns true if the test file could not be run or any of its tests failed.
+func (result *FileResult) Failed() bool {
+	if result.Err != nil {
+		return true
+	}
+	for _, test := range result.Tests {
+		if test.Failed {
+			return true
+		}
+	}
+	return false
+}
+
+// FindFiles returns the sorted test files for patterns.
+// A pattern is a file, a directory, or a directory followed by /... to include all sub directories.
+// Test files are files named *_test.ank.
+func FindFiles(patterns []string) ([]string, error) {
+	found := make(map[string]struct{})
+
+	for _, pattern := range patterns {
+		recursive := false
+		if pattern == "..." || strings.HasSuffix(pattern, "/...") {
+			recursive = true
+			pattern = strings.TrimSuffix(strings.TrimSuffix(pattern, "..."), "/")
+			if pattern == "" {
+				pattern = "."
+			}
+		}
+
+		info, err := os.Stat(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			found[pattern] = struct{}{}
+			continue
+		}
+
+		if !recursive {
+			infos, err := ioutil.ReadDir(pattern)
+			if err != nil {
+				return nil, err
+			}
+			for _, info := range infos {
+				if !info.IsDir() && isTestFile(info.Name()) {
+					found[filepath.Join(pattern, info.Name())] = struct{}{}
+				}
+			}
+			continue
+		}
+
+		err = filepath.Walk(pattern, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				name := info.Name()
+				if path != pattern && (strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if isTestFile(info.Name()) {
+				found[path] = struct{}{}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	files := make([]string, 0, len(found))
+	for file := range found {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// isTestFile returns true if name is a test file name
+func isTestFile(name string) bool {
+	return strings.HasSuffix(name, "_test.ank")
+}
+
+// isTestName returns true if name is a test function name, Test followed by a non lower case letter
+func isTestName(name string) bool {
+	if !strings.HasPrefix(name, "Test") {
+		return false
+	}
+	if len(name) == 4 {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(name[4:])
+	return !unicode.IsLower(r)
+}
+
+// newFilter returns the test name filter for the run pattern, or nil to run all tests
+func newFilter(run string) (func(name string) bool, error) {
+	if run == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(run, "/")
+	regexps := make([]*regexp.Regexp, len(parts))
+	for i, part := range parts {
+		var err error
+		regexps[i], err = regexp.Compile(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid run pattern: %v", err)
+		}
+	}
+
+	return func(name string) bool {
+		for i, element := range strings.Split(name, "/") {
+			if i >= len(regexps) {
+				break
+			}
+			if !regexps[i].MatchString(element) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// Run runs the tests in the test files.
+// Returns an error if the options are not valid, test failures are in the results.
+func Run(ctx context.Context, files []string, options *Options) ([]*FileResult, error) {
+	if options == nil {
+		options = &Options{}
+	}
+
+	filter, err := newFilter(options.Run)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*FileResult, 0, len(files))
+	for _, file := range files {
+		results = append(results, runFile(ctx, file, filter, options))
+	}
+	return results, nil
+}
+
+// runFile runs the tests in the test file
+func runFile(ctx context.Context, file string, filter func(name string) bool, options *Options) *FileResult {
+	start := time.Now()
+	result := &FileResult{File: file}
+	defer func() {
+		result.Elapsed = time.Since(start)
+	}()
+
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	source, err := ioutil.ReadFile(file)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	stmt, err := parser.ParseSrc(string(source))
+	if err != nil {
+		result.Err = fileError(file, err)
+		return result
+	}
+
+	e := env.NewEnv()
+	if options.EnvSetupFunc != nil {
+		options.EnvSetupFunc(e)
+	} else {
+		core.Import(e)
+	}
+	// require is relative to the test file directory
+	modules := core.NewModules(core.SearchPaths()...)
+	modules.EnvSetupFunc = options.EnvSetupFunc
+	if options.Coverage != nil {
+		modules.CoverageFunc = options.Coverage.Coverage
+	}
+	modules.Define(e, filepath.Dir(file))
+	if options.Coverage != nil {
+		defineCoverageLoad(ctx, e, options.Coverage)
+	}
+
+	_, err = vm.RunContext(ctx, e, nil, stmt)
+	if err != nil {
+		result.Err = fileError(file, err)
+		return result
+	}
+
+	for _, name := range e.Symbols() {
+		if !isTestName(name) {
+			continue
+		}
+		if filter != nil && !filter(name) {
+			continue
+		}
+		testFunc, err := vm.FuncOf(e, name, testFuncType)
+		if err != nil {
+			continue
+		}
+
+		t := newT(name, filter)
+		t.run(func() error {
+			rvs := testFunc.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(t)})
+			err, _ := rvs[0].Interface().(error)
+			return err
+		})
+		result.Tests = append(result.Tests, t.result)
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return result
+}
+
+// defineCoverageLoad defines load in the env to run the loaded scripts with coverage
+func defineCoverageLoad(ctx context.Context, e *env.Env, profile *cover.Profile) {
+	e.Define("load", func(file string) interface{} {
+		source, err := ioutil.ReadFile(file)
+		if err != nil {
+			panic(err)
+		}
+		stmt, err := parser.ParseSrc(string(source))
+		if err != nil {
+			if pe, ok := err.(*parser.Error); ok {
+				pe.Filename = file
+			}
+			panic(err)
+		}
+		coverage := profile.Coverage(file, string(source), stmt)
+		rv, err := vm.RunContext(ctx, e, &vm.Options{Coverage: coverage}, stmt)
+		if err != nil {
+			panic(err)
+		}
+		return rv
+	})
+}
+
+// fileError returns err with the file name and position for parser and VM errors
+func fileError(file string, err error) error {
+	switch e := err.(type) {
+	case *parser.Error:
+		return fmt.Errorf("%v:%d:%d: %v", file, e.Pos.Line, e.Pos.Column, e.Message)
+	case *vm.Error:
+		return fmt.Errorf("%v:%d:%d: %v", file, e.Pos.Line, e.Pos.Column, e.Message)
+	}
+	return err
+}
@@ -0,0 +1,122 @@
+package astutil
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/mattn/anko/ast"
+)
+
+var (
+	posType          = reflect.TypeOf((*ast.Pos)(nil)).Elem()
+	reflectValueType = reflect.TypeOf(reflect.Value{})
+)
+
+// Fprint prints the AST node to w as an indented tree, with the position of each statement, expression and operator.
+// The embedded position implementation fields are not printed.
+//
+//	*ast.ExprStmt 1:1 {
+//		Expr: *ast.IdentExpr 1:1 {
+//			Lit: "a"
+//		}
+//	}
+func Fprint(w io.Writer, node interface{}) error {
+	printer := &printer{w: w}
+	printer.print(reflect.ValueOf(node), 0)
+	printer.write("\n")
+	return printer.err
+}
+
+// printer prints an AST, keeping the first write error
+type printer struct {
+	w   io.Writer
+	err error
+}
+
+func (printer *printer) write(s string) {
+	if printer.err != nil {
+		return
+	}
+	_, printer.err = io.WriteString(printer.w, s)
+}
+
+func (printer *printer) print(value reflect.Value, depth int) {
+	indent := strings.Repeat("\t", depth+1)
+
+	if !value.IsValid() {
+		printer.write("nil")
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.Interface:
+		if value.IsNil() {
+			printer.write("nil")
+			return
+		}
+		printer.print(value.Elem(), depth)
+
+	case reflect.Ptr:
+		if value.IsNil() {
+			printer.write("nil")
+			return
+		}
+		if value.Type().Implements(posType) {
+			pos := value.Interface().(ast.Pos).Position()
+			printer.write(fmt.Sprintf("%v %v:%v ", value.Type(), pos.Line, pos.Column))
+		} else {
+			printer.write("*")
+		}
+		printer.print(value.Elem(), depth)
+
+	case reflect.Struct:
+		if value.Type() == reflectValueType {
+			printer.printLiteral(value.Interface().(reflect.Value))
+			return
+		}
+		if value.Type().Name() != "" && !reflect.PtrTo(value.Type()).Implements(posType) {
+			printer.write(value.Type().String() + " ")
+		}
+		printer.write("{\n")
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.Anonymous || field.PkgPath != "" {
+				continue
+			}
+			printer.write(indent + field.Name + ": ")
+			printer.print(value.Field(i), depth+1)
+			printer.write("\n")
+		}
+		printer.write(indent[1:] + "}")
+
+	case reflect.Slice:
+		if value.Len() == 0 {
+			printer.write("[]")
+			return
+		}
+		printer.write(value.Type().String() + " {\n")
+		for i := 0; i < value.Len(); i++ {
+			printer.write(fmt.Sprintf("%v%v: ", indent, i))
+			printer.print(value.Index(i), depth+1)
+			printer.write("\n")
+		}
+		printer.write(indent[1:] + "}")
+
+	case reflect.String:
+		printer.write(fmt.Sprintf("%q", value.String()))
+
+	default:
+		printer.write(fmt.Sprint(value.Interface()))
+	}
+}
+
+// printLiteral prints the value of a literal expression with its type
+func (printer *printer) printLiteral(value reflect.Value) {
+	if !value.IsValid() || ((value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr) && value.IsNil()) {
+		printer.write("nil")
+		return
+	}
+	printer.write(fmt.Sprintf("%#v", value.Interface()))
+}
@@ -0,0 +1,90 @@
+package astutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/parser"
+)
+
+func TestFprint(t *testing.T) {
+	tests := []struct {
+		src      string
+		expected string
+	}{
+		{src: "a", expected: `*ast.ExprStmt 1:1 {
+	Expr: *ast.IdentExpr 1:1 {
+		Lit: "a"
+	}
+}
+`},
+		{src: "a = [1, \"b\", nil]", expected: `*ast.LetsStmt 1:1 {
+	LHSS: []ast.Expr {
+		0: *ast.IdentExpr 1:1 {
+			Lit: "a"
+		}
+	}
+	RHSS: []ast.Expr {
+		0: *ast.ArrayExpr 1:17 {
+			Exprs: []ast.Expr {
+				0: *ast.LiteralExpr 1:6 {
+					Literal: 1
+				}
+				1: *ast.LiteralExpr 1:9 {
+					Literal: "b"
+				}
+				2: *ast.LiteralExpr 1:14 {
+					Literal: nil
+				}
+			}
+			TypeData: nil
+		}
+	}
+}
+`},
+		{src: "var a = make([]int)", expected: `*ast.VarStmt 1:1 {
+	Names: []string {
+		0: "a"
+	}
+	Exprs: []ast.Expr {
+		0: *ast.MakeExpr 1:9 {
+			TypeData: *ast.TypeStruct {
+				Kind: 2
+				Env: []
+				Name: "int"
+				Dimensions: 1
+				SubType: nil
+				Key: nil
+				StructNames: []
+				StructTypes: []
+			}
+			LenExpr: nil
+			CapExpr: nil
+		}
+	}
+}
+`},
+	}
+
+	for _, test := range tests {
+		stmt, err := parser.ParseSrc(test.src)
+		if err != nil {
+			t.Fatalf("ParseSrc error: %v - src: %v", err, test.src)
+		}
+		var buffer bytes.Buffer
+		err = Fprint(&buffer, stmt.(*ast.StmtsStmt).Stmts[0])
+		if err != nil {
+			t.Fatalf("Fprint error: %v - src: %v", err, test.src)
+		}
+		if buffer.String() != test.expected {
+			t.Errorf("Fprint - received: %v - expected: %v - src: %v", buffer.String(), test.expected, test.src)
+		}
+	}
+
+	var buffer bytes.Buffer
+	err := Fprint(&buffer, nil)
+	if err != nil || buffer.String() != "nil\n" {
+		t.Errorf("Fprint - received: %q, %v - expected: %q", buffer.String(), err, "nil\n")
+	}
+}
@@ -63,6 +63,8 @@ func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 		return walkExpr(stmt.Expr, f)
 	case *ast.VarStmt:
 		return walkExprs(stmt.Exprs, f)
+	case *ast.ConstStmt:
+		return walkExprs(stmt.Exprs, f)
 	case *ast.LetsStmt:
 		if err := walkExprs(stmt.RHSS, f); err != nil {
 			return err
@@ -132,6 +134,9 @@ func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 		}
 		for _, switchCaseStmt := range stmt.Cases {
 			caseStmt := switchCaseStmt.(*ast.SwitchCaseStmt)
+			if err := walkExprs(caseStmt.Exprs, f); err != nil {
+				return err
+			}
 			if err := walkStmt(caseStmt.Stmt, f); err != nil {
 				return err
 			}
@@ -141,6 +146,21 @@ func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 		}
 	case *ast.GoroutineStmt:
 		return walkExpr(stmt.Expr, f)
+	case *ast.DeleteStmt:
+		if err := walkExpr(stmt.Item, f); err != nil {
+			return err
+		}
+		return walkExpr(stmt.Key, f)
+	case *ast.CloseStmt:
+		return walkExpr(stmt.Expr, f)
+	case *ast.ChanStmt:
+		if err := walkExpr(stmt.RHS, f); err != nil {
+			return err
+		}
+		if err := walkExpr(stmt.LHS, f); err != nil {
+			return err
+		}
+		return walkExpr(stmt.OkExpr, f)
 	default:
 		return fmt.Errorf("unknown statement %v", reflect.TypeOf(stmt))
 	}
@@ -159,6 +179,7 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 	case *ast.OpExpr:
 		return walkOperator(expr.Op, f)
 	case *ast.LenExpr:
+		return walkExpr(expr.Expr, f)
 	case *ast.LiteralExpr:
 	case *ast.IdentExpr:
 	case *ast.MemberExpr:
@@ -217,6 +238,11 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 			return err
 		}
 		return walkExpr(expr.RHS, f)
+	case *ast.NilCoalescingOpExpr:
+		if err := walkExpr(expr.LHS, f); err != nil {
+			return err
+		}
+		return walkExpr(expr.RHS, f)
 	case *ast.ImportExpr:
 		return walkExpr(expr.Name, f)
 	case *ast.MakeExpr:
@@ -224,6 +250,8 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 			return err
 		}
 		return walkExpr(expr.CapExpr, f)
+	case *ast.MakeTypeExpr:
+		return walkExpr(expr.Type, f)
 	case *ast.ChanExpr:
 		if err := walkExpr(expr.RHS, f); err != nil {
 			return err
@@ -106,6 +106,12 @@ func Main(arg1) {
 	x = f()[0:1]
 	y = f()[0]
 	fmt.Println(x == y ? true : false)
+
+	y = x ?? "none"
+	delete(a, "foo")
+	close(c)
+	v, ok = <-c
+	make(type myString, "")
 }
 
 func Tester() {
@@ -127,6 +133,7 @@ func TestWalk(t *testing.T) {
 	}
 	var mainFound bool
 	var lenFound bool
+	found := make(map[string]bool)
 	err = Walk(stmts, func(e interface{}) error {
 		switch exp := e.(type) {
 		case *ast.CallExpr:
@@ -152,12 +159,19 @@ func TestWalk(t *testing.T) {
 			}
 		case *ast.LenExpr:
 			lenFound = true
+		case *ast.NilCoalescingOpExpr, *ast.DeleteStmt, *ast.CloseStmt, *ast.ChanStmt, *ast.MakeTypeExpr:
+			found[fmt.Sprintf("%T", e)] = true
 		}
 		return nil
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
+	for _, name := range []string{"*ast.NilCoalescingOpExpr", "*ast.DeleteStmt", "*ast.CloseStmt", "*ast.ChanStmt", "*ast.MakeTypeExpr"} {
+		if !found[name] {
+			t.Errorf("%v not found", name)
+		}
+	}
 	if !mainFound {
 		t.Fatal("Main not found")
 	}
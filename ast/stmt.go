@@ -115,6 +115,13 @@ type VarStmt struct {
 	Exprs []Expr
 }
 
+// ConstStmt provide statement to define constants in current scope.
+type ConstStmt struct {
+	StmtImpl
+	Names []string
+	Exprs []Expr
+}
+
 // LetsStmt provide multiple statement of let.
 type LetsStmt struct {
 	StmtImpl
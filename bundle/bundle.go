@@ -0,0 +1,306 @@
+// Package bundle builds a standalone executable from an anko script.
+//
+// The script and the files it loads with a constant file name are embedded in a generated Go main package,
+// which links the core builtins and the selected package bindings, and is built with the Go toolchain.
+//
+//	err := bundle.Build(ctx, "main.ank", &bundle.Options{Output: "tool", Packages: []string{"fmt", "strings"}})
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/ast/astutil"
+	"github.com/mattn/anko/parser"
+)
+
+// modulePath is the path of the anko module the generated main package requires
+const modulePath = "github.com/mattn/anko"
+
+// Options are the options for building an executable.
+type Options struct {
+	// Output is the file name of the executable. Defaults to the main script file name without extension.
+	Output string
+	// Packages are the names of the package bindings linked in, like "strings" and "net/http".
+	// Nil links all package bindings, an empty slice links none.
+	Packages []string
+	// AnkoDir is the directory of the anko module source, the package bindings are copied from its packages directory.
+	// Defaults to the directory reported by go list for the anko module.
+	AnkoDir string
+	// WorkDir is the directory the main package is generated in. Defaults to a temporary directory that is removed after building.
+	WorkDir string
+	// Warnf is called for loads that cannot be embedded. Defaults to ignoring them.
+	Warnf func(format string, a ...interface{})
+}
+
+// Script is a script file embedded in the executable.
+type Script struct {
+	// Name is the file name as loaded by the script, or the main script file name.
+	Name string
+	// Source is the script source.
+	Source string
+}
+
+// Build generates the main package for the main script file and builds it with the Go toolchain.
+func Build(ctx context.Context, mainFile string, options *Options) error {
+	if options == nil {
+		options = &Options{}
+	}
+
+	output := options.Output
+	if output == "" {
+		output = strings.TrimSuffix(filepath.Base(mainFile), filepath.Ext(mainFile))
+	}
+	output, err := filepath.Abs(output)
+	if err != nil {
+		return err
+	}
+
+	workDir := options.WorkDir
+	if workDir == "" {
+		workDir, err = ioutil.TempDir("", "anko-build")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(workDir)
+	}
+
+	err = Generate(ctx, workDir, mainFile, options)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "go", "build", "-o", output, ".")
+	cmd.Dir = workDir
+	cmd.Env = append(os.Environ(), "GO111MODULE=on")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("go build error: %v\n%s", err, out)
+	}
+	return nil
+}
+
+// Generate writes the main package for the main script file to dir:
+// go.mod, main.go with the embedded scripts and the selected package binding files.
+func Generate(ctx context.Context, dir string, mainFile string, options *Options) error {
+	if options == nil {
+		options = &Options{}
+	}
+
+	scripts, err := FindScripts(mainFile, options.Warnf)
+	if err != nil {
+		return err
+	}
+
+	ankoDir := options.AnkoDir
+	if ankoDir == "" {
+		ankoDir, err = findAnkoDir(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	ankoDir, err = filepath.Abs(ankoDir)
+	if err != nil {
+		return err
+	}
+
+	var bindingFiles []string
+	if options.Packages != nil {
+		bindingFiles, err = findBindingFiles(filepath.Join(ankoDir, "packages"), options.Packages)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	goMod := fmt.Sprintf("module anko-build\n\ngo 1.13\n\nrequire %v v0.0.0\n\nreplace %v => %v\n", modulePath, modulePath, quoteModPath(ankoDir))
+	err = ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0644)
+	if err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+	err = mainTemplate.Execute(&buffer, map[string]interface{}{
+		"AllPackages": options.Packages == nil,
+		"Main":        scripts[0].Name,
+		"Scripts":     scripts,
+	})
+	if err != nil {
+		return err
+	}
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("format generated main error: %v", err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "main.go"), source, 0644)
+	if err != nil {
+		return err
+	}
+
+	for _, bindingFile := range bindingFiles {
+		err = copyBindingFile(bindingFile, filepath.Join(dir, "bindings_"+filepath.Base(bindingFile)))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindScripts returns the main script and the scripts it loads, recursively.
+// Loads with a constant file name are found, other loads are passed to warnf if not nil.
+// A loaded file name is relative to the working directory, like the load builtin,
+// or if not found there, relative to the directory of the main script.
+func FindScripts(mainFile string, warnf func(format string, a ...interface{})) ([]Script, error) {
+	source, err := ioutil.ReadFile(mainFile)
+	if err != nil {
+		return nil, err
+	}
+	scripts := []Script{{Name: filepath.Base(mainFile), Source: string(source)}}
+	found := map[string]struct{}{scripts[0].Name: {}}
+
+	paths := []string{mainFile}
+	for i := 0; i < len(scripts); i++ {
+		names, err := findLoads(paths[i], scripts[i].Source, warnf)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if _, ok := found[name]; ok {
+				continue
+			}
+			found[name] = struct{}{}
+
+			path := name
+			if _, err := os.Stat(path); err != nil && !filepath.IsAbs(name) {
+				path = filepath.Join(filepath.Dir(mainFile), name)
+			}
+			source, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("%v: load %q: %v", paths[i], name, err)
+			}
+			scripts = append(scripts, Script{Name: name, Source: string(source)})
+			paths = append(paths, path)
+		}
+	}
+
+	return scripts, nil
+}
+
+// findLoads returns the constant file names of the load calls in the script source
+func findLoads(path string, source string, warnf func(format string, a ...interface{})) ([]string, error) {
+	stmt, err := parser.ParseSrc(source)
+	if err != nil {
+		if e, ok := err.(*parser.Error); ok {
+			return nil, fmt.Errorf("%v:%v:%v: %v", path, e.Pos.Line, e.Pos.Column, err)
+		}
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+
+	var names []string
+	err = astutil.Walk(stmt, func(node interface{}) error {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || call.Name != "load" {
+			return nil
+		}
+		if len(call.SubExprs) == 1 {
+			if literal, ok := call.SubExprs[0].(*ast.LiteralExpr); ok && literal.Literal.Kind() == reflect.String {
+				names = append(names, literal.Literal.String())
+				return nil
+			}
+		}
+		if warnf != nil {
+			pos := call.Position()
+			warnf("%v:%v:%v: load without a constant file name is not embedded", path, pos.Line, pos.Column)
+		}
+		return nil
+	})
+	return names, err
+}
+
+// findAnkoDir returns the directory of the anko module source using go list
+func findAnkoDir(ctx context.Context) (string, error) {
+	cmd := exec.CommandContext(ctx, "go", "list", "-m", "-f", "{{.Dir}}", modulePath)
+	out, err := cmd.Output()
+	dir := strings.TrimSpace(string(out))
+	if err != nil || dir == "" {
+		return "", errors.New("cannot find the anko module source directory, set the anko directory option")
+	}
+	return dir, nil
+}
+
+// findBindingFiles returns the binding files for the packages in the packages directory.
+// The bindings of package a/b are in a.b.go and in files for specific build tags starting with a.b followed by an upper case letter.
+func findBindingFiles(packagesDir string, packages []string) ([]string, error) {
+	fileInfos, err := ioutil.ReadDir(packagesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	filesByPackage := make(map[string][]string)
+	for _, fileInfo := range fileInfos {
+		name := fileInfo.Name()
+		if fileInfo.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		base := strings.TrimSuffix(name, ".go")
+		if index := strings.IndexFunc(base, unicode.IsUpper); index >= 0 {
+			base = base[:index]
+		}
+		packageName := strings.Replace(base, ".", "/", -1)
+		filesByPackage[packageName] = append(filesByPackage[packageName], filepath.Join(packagesDir, name))
+	}
+
+	var files []string
+	added := make(map[string]struct{})
+	for _, packageName := range packages {
+		packageFiles, ok := filesByPackage[packageName]
+		if !ok {
+			return nil, fmt.Errorf("no bindings for package '%v'", packageName)
+		}
+		if _, ok := added[packageName]; ok {
+			continue
+		}
+		added[packageName] = struct{}{}
+		files = append(files, packageFiles...)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// copyBindingFile copies the binding file to the main package
+func copyBindingFile(source string, destination string) error {
+	data, err := ioutil.ReadFile(source)
+	if err != nil {
+		return err
+	}
+	data = bytes.Replace(data, []byte("\npackage packages\n"), []byte("\npackage main\n"), 1)
+	if bytes.HasPrefix(data, []byte("package packages\n")) {
+		data = append([]byte("package main\n"), data[len("package packages\n"):]...)
+	}
+	return ioutil.WriteFile(destination, data, 0644)
+}
+
+// quoteModPath quotes the path for go.mod if it contains spaces or quotes
+func quoteModPath(path string) string {
+	if strings.ContainsAny(path, " \t\"'`") {
+		return fmt.Sprintf("%q", path)
+	}
+	return path
+}
@@ -0,0 +1,213 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestFindScripts(t *testing.T) {
+	var warnings []string
+	warnf := func(format string, a ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(format, a...))
+	}
+	mainFile := filepath.Join("testdata", "main.ank")
+
+	scripts, err := FindScripts(mainFile, warnf)
+	if err != nil {
+		t.Fatal("FindScripts error:", err)
+	}
+	var names []string
+	for _, script := range scripts {
+		names = append(names, script.Name)
+	}
+	expected := []string{"main.ank", "lib/util.ank", "lib/constants.ank"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("script names - received: %v - expected: %v", names, expected)
+	}
+	if scripts[2].Source != "greeting = \"hello\"\n" {
+		t.Errorf("script source - received: %q - expected: %q", scripts[2].Source, "greeting = \"hello\"\n")
+	}
+	expected = []string{mainFile + ":8:2: load without a constant file name is not embedded"}
+	if !reflect.DeepEqual(warnings, expected) {
+		t.Errorf("warnings - received: %v - expected: %v", warnings, expected)
+	}
+
+	dir, err := ioutil.TempDir("", "anko-bundle")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		source string
+		err    string
+	}{
+		{source: "load(\"missing.ank\")", err: "main.ank: load \"missing.ank\": open " + filepath.Join(dir, "missing.ank") + ": no such file or directory"},
+		{source: "a = 1\nload(", err: "main.ank:2:6: syntax error"},
+	}
+	for _, test := range tests {
+		err = ioutil.WriteFile(filepath.Join(dir, "main.ank"), []byte(test.source), 0644)
+		if err != nil {
+			t.Fatal("WriteFile error:", err)
+		}
+		_, err = FindScripts(filepath.Join(dir, "main.ank"), nil)
+		if err == nil || strings.TrimPrefix(err.Error(), dir+string(filepath.Separator)) != test.err {
+			t.Errorf("FindScripts error - received: %v - expected: %v", err, test.err)
+		}
+	}
+
+	_, err = FindScripts(filepath.Join(dir, "missing.ank"), nil)
+	if err == nil {
+		t.Error("FindScripts error - received: nil - expected: missing file error")
+	}
+}
+
+func TestFindBindingFiles(t *testing.T) {
+	packagesDir := filepath.Join("testdata", "packages")
+	tests := []struct {
+		packages []string
+		files    []string
+		err      string
+	}{
+		{packages: []string{}, files: nil},
+		{packages: []string{"strings"}, files: []string{"strings.go", "stringsGo118.go", "stringsNotGo118.go"}},
+		{packages: []string{"sort"}, files: []string{"sort.go", "sortHelpers.go"}},
+		{packages: []string{"net"}, files: []string{"net.go"}},
+		{packages: []string{"net/http", "os", "net/http"}, files: []string{"net.http.go", "net.httpGo116.go", "net.httpNotGo116.go", "os.go"}},
+		{packages: []string{"strings", "foo"}, err: "no bindings for package 'foo'"},
+	}
+
+	for _, test := range tests {
+		files, err := findBindingFiles(packagesDir, test.packages)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("findBindingFiles error - received: %v - expected: %v - packages: %v", err, test.err, test.packages)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("findBindingFiles error: %v - packages: %v", err, test.packages)
+		}
+		var names []string
+		for _, file := range files {
+			names = append(names, filepath.Base(file))
+		}
+		if !reflect.DeepEqual(names, test.files) {
+			t.Errorf("files - received: %v - expected: %v - packages: %v", names, test.files, test.packages)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "anko-bundle")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = Generate(context.Background(), dir, filepath.Join("testdata", "main.ank"), &Options{AnkoDir: "..", Packages: []string{"strings"}})
+	if err != nil {
+		t.Fatal("Generate error:", err)
+	}
+
+	fileInfos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal("ReadDir error:", err)
+	}
+	var names []string
+	for _, fileInfo := range fileInfos {
+		names = append(names, fileInfo.Name())
+	}
+	bindingFiles, err := findBindingFiles(filepath.Join("..", "packages"), []string{"strings"})
+	if err != nil {
+		t.Fatal("findBindingFiles error:", err)
+	}
+	var expected []string
+	for _, file := range bindingFiles {
+		expected = append(expected, "bindings_"+filepath.Base(file))
+	}
+	expected = append(expected, "go.mod", "main.go")
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("files - received: %v - expected: %v", names, expected)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "bindings_strings.go"))
+	if err != nil {
+		t.Fatal("ReadFile error:", err)
+	}
+	if !strings.Contains(string(data), "\npackage main\n") || strings.Contains(string(data), "package packages") {
+		t.Errorf("binding file is not in package main: %.120q", data)
+	}
+
+	data, err = ioutil.ReadFile(filepath.Join(dir, "main.go"))
+	if err != nil {
+		t.Fatal("ReadFile error:", err)
+	}
+	for _, expected := range []string{`"lib/constants.ank": "greeting = \"hello\"\n",`, `const scriptMain = "main.ank"`} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("main.go does not contain %v", expected)
+		}
+	}
+	if strings.Contains(string(data), `"github.com/mattn/anko/packages"`) {
+		t.Error("main.go imports all package bindings")
+	}
+}
+
+func TestBuild(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping building with the Go toolchain in short mode")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+
+	dir, err := ioutil.TempDir("", "anko-bundle")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(dir)
+	output := filepath.Join(dir, "tool")
+	if runtime.GOOS == "windows" {
+		output += ".exe"
+	}
+
+	err = Build(context.Background(), filepath.Join("testdata", "main.ank"), &Options{Output: output, AnkoDir: "..", Packages: []string{"strings"}})
+	if err != nil {
+		t.Fatal("Build error:", err)
+	}
+
+	// run in another directory to only use the embedded scripts
+	cmd := exec.Command(output, "a", "b")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	exitError, ok := err.(*exec.ExitError)
+	if !ok || exitError.ExitCode() != 2 {
+		t.Errorf("exit error - received: %v - expected: exit status 2", err)
+	}
+	if string(out) != "HELLO 2\n" {
+		t.Errorf("output - received: %q - expected: %q", out, "HELLO 2\n")
+	}
+
+	err = Build(context.Background(), filepath.Join("testdata", "main.ank"), &Options{Output: output, AnkoDir: "..", Packages: []string{}})
+	if err != nil {
+		t.Fatal("Build error:", err)
+	}
+	cmd = exec.Command(output)
+	cmd.Dir = dir
+	out, err = cmd.CombinedOutput()
+	exitError, ok = err.(*exec.ExitError)
+	if !ok || exitError.ExitCode() != 4 {
+		t.Errorf("exit error - received: %v - expected: exit status 4", err)
+	}
+	if string(out) != "main.ank:4:11: package not found: strings\n" {
+		t.Errorf("output - received: %q - expected: %q", out, "main.ank:4:11: package not found: strings\n")
+	}
+}
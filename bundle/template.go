@@ -0,0 +1,136 @@
+package bundle
+
+import "text/template"
+
+// mainTemplate is the template of the generated main.go
+var mainTemplate = template.Must(template.New("main").Parse(`// Code generated by anko build. DO NOT EDIT.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"sync"
+
+	"github.com/mattn/anko/core"
+	"github.com/mattn/anko/env"
+{{- if .AllPackages}}
+	_ "github.com/mattn/anko/packages"
+{{- end}}
+	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/vm"
+)
+
+// exit codes, a script can exit with any code by calling exit(code)
+const (
+	exitCodeParseError = 3
+	exitCodeRunError   = 4
+	exitCodeInterrupt  = 130
+)
+
+// scriptMain is the file name of the main script
+const scriptMain = {{printf "%q" .Main}}
+
+// scripts are the embedded scripts by file name
+var scripts = map[string]string{
+{{- range .Scripts}}
+	{{printf "%q" .Name}}: {{printf "%q" .Source}},
+{{- end}}
+}
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var interrupted bool
+	var exitCode int
+	var exited bool
+	var mutex sync.Mutex
+
+	chanSignal := make(chan os.Signal, 1)
+	signal.Notify(chanSignal, os.Interrupt)
+	defer signal.Stop(chanSignal)
+	go func() {
+		select {
+		case <-chanSignal:
+			mutex.Lock()
+			interrupted = true
+			mutex.Unlock()
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	e := env.NewEnv()
+	e.Define("args", os.Args[1:])
+	core.Import(e)
+	e.Define("exit", func(code int64) {
+		mutex.Lock()
+		if !exited {
+			exitCode = int(code)
+			exited = true
+		}
+		mutex.Unlock()
+		cancel()
+	})
+	e.Define("load", func(name string) interface{} {
+		value, err := runScript(ctx, e, name)
+		if err != nil {
+			panic(err)
+		}
+		return value
+	})
+
+	_, err := runScript(ctx, e, scriptMain)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if exited {
+		return exitCode
+	}
+	if err == nil {
+		return 0
+	}
+	switch e := err.(type) {
+	case *parser.Error:
+		fmt.Fprintf(os.Stderr, "%v:%v:%v: %v\n", e.Filename, e.Pos.Line, e.Pos.Column, err)
+		return exitCodeParseError
+	case *vm.Error:
+		fmt.Fprintf(os.Stderr, "%v:%v:%v: %v\n", scriptMain, e.Pos.Line, e.Pos.Column, err)
+	default:
+		fmt.Fprintf(os.Stderr, "%v: %v\n", scriptMain, err)
+	}
+	if interrupted {
+		return exitCodeInterrupt
+	}
+	return exitCodeRunError
+}
+
+// runScript runs the embedded script, or the script file if it is not embedded
+func runScript(ctx context.Context, e *env.Env, name string) (interface{}, error) {
+	source, ok := scripts[name]
+	if !ok {
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		source = string(data)
+	}
+
+	stmt, err := parser.ParseSrc(source)
+	if err != nil {
+		if e, ok := err.(*parser.Error); ok {
+			e.Filename = name
+		}
+		return nil, err
+	}
+	return vm.RunContext(ctx, e, nil, stmt)
+}
+`))
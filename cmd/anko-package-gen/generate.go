@@ -0,0 +1,331 @@
+// +build go1.18
+
+package main
+
+import (
+	"bytes"
+	"go/constant"
+	"go/format"
+	"go/token"
+	"go/types"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// binding is a symbol of a package and the Go expression of its reflect value or type
+type binding struct {
+	Name  string
+	Expr  string
+	Minor int
+}
+
+// generatedFile is a generated file name and source
+type generatedFile struct {
+	Name   string
+	Source []byte
+}
+
+// bindingFile is the data of the file templates
+type bindingFile struct {
+	Package    string
+	Tags       string
+	ImportPath string
+	ImportName string
+	Values     []binding
+	Types      []binding
+	// HasTypes is true if the package has types, in this file or in a version file
+	HasTypes bool
+	// Hook is the function of a version file
+	Hook string
+	// Hooks are the functions of the version files called by the main file
+	Hooks []string
+	// Version is the build tag of a version file, like go1.18 or !go1.18
+	Version string
+	// Helpers is the function of the helpers file of the package called by the main file after the bindings
+	Helpers string
+}
+
+// generateOptions are the options of the generated files of a package
+type generateOptions struct {
+	// Package is the package name of the generated files
+	Package string
+	// Tags is the build constraint of the generated files
+	Tags string
+	// Versions are the Go versions of the symbols
+	Versions goVersions
+	// Minor is the Go 1 minor version the bindings are built with, symbols added later are in version files
+	Minor int
+	// Helpers is true if the package has a helpers file, like net.httpHelpers.go with the function netHttpHelpers
+	Helpers bool
+	// Exclude are the names of the symbols that are not bound
+	Exclude map[string]struct{}
+}
+
+// generatedComment is the first line of the generated files
+const generatedComment = "// Code generated by anko-package-gen. DO NOT EDIT."
+
+const fileHeader = generatedComment + `
+
+{{if .Version}}// +build {{.Version}}
+{{end}}{{if .Tags}}// +build {{.Tags}}
+{{end}}{{if or .Version .Tags}}
+{{end}}package {{.Package}}
+`
+
+const fileImports = `
+import (
+{{- if ne .ImportPath "reflect"}}
+	{{.ImportName}} "{{.ImportPath}}"
+{{- end}}
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+`
+
+var (
+	fileTemplate = template.Must(template.New("file").Parse(fileHeader + fileImports + `
+func init() {
+	env.Packages["{{.ImportPath}}"] = map[string]reflect.Value{
+{{- range .Values}}
+		"{{.Name}}": {{.Expr}},
+{{- end}}
+	}
+{{- if .HasTypes}}
+	env.PackageTypes["{{.ImportPath}}"] = map[string]reflect.Type{
+{{- range .Types}}
+		"{{.Name}}": {{.Expr}},
+{{- end}}
+	}
+{{- end}}
+{{- range .Hooks}}
+	{{.}}()
+{{- end}}
+{{- if .Helpers}}
+	{{.Helpers}}()
+{{- end}}
+}
+`))
+
+	versionFileTemplate = template.Must(template.New("version").Parse(fileHeader + fileImports + `
+func {{.Hook}}() {
+{{- range .Values}}
+	env.Packages["{{$.ImportPath}}"]["{{.Name}}"] = {{.Expr}}
+{{- end}}
+{{- range .Types}}
+	env.PackageTypes["{{$.ImportPath}}"]["{{.Name}}"] = {{.Expr}}
+{{- end}}
+}
+`))
+
+	notVersionFileTemplate = template.Must(template.New("notVersion").Parse(fileHeader + `
+func {{.Hook}}() {}
+`))
+)
+
+// generate returns the binding files of pkg. The symbols added after the Go 1 minor version of options
+// are in version files with a go1.N build tag, with a stub file for older versions.
+func generate(pkg *types.Package, options generateOptions) ([]generatedFile, error) {
+	main := bindingFile{
+		Package:    options.Package,
+		Tags:       options.Tags,
+		ImportPath: pkg.Path(),
+	}
+	if pkg.Name() != path.Base(pkg.Path()) {
+		main.ImportName = pkg.Name()
+	}
+	if options.Helpers {
+		main.Helpers = helpersName(pkg.Path())
+	}
+	values, typeBindings := bindings(pkg, options.Versions, options.Exclude)
+	main.HasTypes = len(typeBindings) > 0
+
+	// group the bindings by the minor version of the version file, 0 for the main file
+	groups := make(map[int]*bindingFile)
+	var groupMinors []int
+	group := func(bindingMinor int) *bindingFile {
+		if bindingMinor <= options.Minor {
+			return &main
+		}
+		file, ok := groups[bindingMinor]
+		if !ok {
+			file = &bindingFile{
+				Package:    main.Package,
+				Tags:       main.Tags,
+				ImportPath: main.ImportPath,
+				ImportName: main.ImportName,
+				Hook:       hookName(pkg.Path(), bindingMinor),
+			}
+			groups[bindingMinor] = file
+			groupMinors = append(groupMinors, bindingMinor)
+		}
+		return file
+	}
+	for _, value := range values {
+		file := group(value.Minor)
+		file.Values = append(file.Values, value)
+	}
+	for _, typeBinding := range typeBindings {
+		file := group(typeBinding.Minor)
+		file.Types = append(file.Types, typeBinding)
+	}
+	sort.Ints(groupMinors)
+
+	base := strings.TrimSuffix(fileName(pkg.Path()), ".go")
+	var files []generatedFile
+	for _, groupMinor := range groupMinors {
+		file := groups[groupMinor]
+		main.Hooks = append(main.Hooks, file.Hook)
+		suffix := "Go1" + strconv.Itoa(groupMinor) + ".go"
+
+		file.Version = "go1." + strconv.Itoa(groupMinor)
+		source, err := executeTemplate(versionFileTemplate, file)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, generatedFile{Name: base + suffix, Source: source})
+
+		file.Version = "!" + file.Version
+		source, err = executeTemplate(notVersionFileTemplate, file)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, generatedFile{Name: base + "Not" + suffix, Source: source})
+	}
+
+	source, err := executeTemplate(fileTemplate, &main)
+	if err != nil {
+		return nil, err
+	}
+	return append([]generatedFile{{Name: base + ".go", Source: source}}, files...), nil
+}
+
+// executeTemplate returns the formatted source of the template executed with file
+func executeTemplate(fileTemplate *template.Template, file *bindingFile) ([]byte, error) {
+	var buffer bytes.Buffer
+	err := fileTemplate.Execute(&buffer, file)
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buffer.Bytes())
+}
+
+// hookName returns the name of the version file function of the package path, like netHttpGo118 for net/http and go1.18
+func hookName(path string, minor int) string {
+	return identifier(path) + "Go1" + strconv.Itoa(minor)
+}
+
+// helpersName returns the name of the helpers file function of the package path, like netHttpHelpers for net/http
+func helpersName(path string) string {
+	return identifier(path) + "Helpers"
+}
+
+// identifier returns the package path as a camel case identifier, like netHttp for net/http
+func identifier(path string) string {
+	parts := strings.FieldsFunc(path, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// bindings returns the value and type bindings of the exported symbols of pkg, sorted by name.
+// Generic functions and types, constants that do not fit in a Go type and the exclude names are skipped.
+// Minor is set to the Go 1 minor version that added the symbol.
+func bindings(pkg *types.Package, versions goVersions, exclude map[string]struct{}) ([]binding, []binding) {
+	var values []binding
+	var typeBindings []binding
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		if !token.IsExported(name) {
+			continue
+		}
+		if _, ok := exclude[name]; ok {
+			continue
+		}
+		qualified := pkg.Name() + "." + name
+		minor := versions.minor(pkg.Path(), name)
+		switch object := scope.Lookup(name).(type) {
+		case *types.Func:
+			if isGeneric(object.Type()) {
+				continue
+			}
+			values = append(values, binding{Name: name, Expr: "reflect.ValueOf(" + qualified + ")", Minor: minor})
+		case *types.Var:
+			values = append(values, binding{Name: name, Expr: "reflect.ValueOf(" + qualified + ")", Minor: minor})
+		case *types.Const:
+			expr, ok := constExpr(object, qualified)
+			if ok {
+				values = append(values, binding{Name: name, Expr: expr, Minor: minor})
+			}
+		case *types.TypeName:
+			if isGeneric(object.Type()) {
+				continue
+			}
+			typeBindings = append(typeBindings, binding{Name: name, Expr: typeExpr(object.Type(), qualified), Minor: minor})
+		}
+	}
+	return values, typeBindings
+}
+
+// isGeneric returns true if the function or type has type parameters
+func isGeneric(t types.Type) bool {
+	typeParams, ok := t.(interface{ TypeParams() *types.TypeParamList })
+	return ok && typeParams.TypeParams().Len() > 0
+}
+
+// constExpr returns the reflect value expression of the constant.
+// Untyped integer constants that do not fit in an int32 are converted to int64 or uint64 so the binding
+// compiles on all platforms, and constants that do not fit in any Go type return false.
+func constExpr(object *types.Const, qualified string) (string, bool) {
+	basic, ok := object.Type().(*types.Basic)
+	if !ok || basic.Info()&types.IsUntyped == 0 {
+		return "reflect.ValueOf(" + qualified + ")", true
+	}
+
+	value := object.Val()
+	switch basic.Kind() {
+	case types.UntypedInt:
+		if i, exact := constant.Int64Val(value); exact {
+			if i < math.MinInt32 || i > math.MaxInt32 {
+				return "reflect.ValueOf(int64(" + qualified + "))", true
+			}
+			return "reflect.ValueOf(" + qualified + ")", true
+		}
+		if _, exact := constant.Uint64Val(value); exact {
+			return "reflect.ValueOf(uint64(" + qualified + "))", true
+		}
+		return "", false
+	case types.UntypedFloat:
+		if f, _ := constant.Float64Val(value); math.IsInf(f, 0) {
+			return "", false
+		}
+	}
+	return "reflect.ValueOf(" + qualified + ")", true
+}
+
+// typeExpr returns the reflect type expression of the named type,
+// with a composite literal or zero value for the types that have one
+func typeExpr(t types.Type, qualified string) string {
+	switch underlying := t.Underlying().(type) {
+	case *types.Struct, *types.Slice, *types.Map, *types.Array:
+		return "reflect.TypeOf(" + qualified + "{})"
+	case *types.Basic:
+		switch {
+		case underlying.Info()&types.IsBoolean != 0:
+			return "reflect.TypeOf(" + qualified + "(false))"
+		case underlying.Info()&types.IsString != 0:
+			return "reflect.TypeOf(" + qualified + "(\"\"))"
+		case underlying.Info()&types.IsNumeric != 0:
+			return "reflect.TypeOf(" + qualified + "(0))"
+		}
+	}
+	return "reflect.TypeOf((*" + qualified + ")(nil)).Elem()"
+}
@@ -0,0 +1,278 @@
+// +build go1.18
+
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testSource = `package example
+
+import "io"
+
+const (
+	Small    = 1
+	Big      = 1 << 40
+	Negative = -1 << 40
+	Unsigned = 1 << 63
+	Huge     = 1 << 70
+	Float    = 1.5
+	Typed    int8 = 2
+	private  = 3
+)
+
+var Reader io.Reader
+
+type (
+	Struct    struct{ A int }
+	Slice     []int
+	Interface interface{ M() }
+	Duration  int64
+	Name      string
+	Flag      bool
+	Func      func()
+	Alias     = Struct
+	Generic[T any] struct{ V T }
+)
+
+func Exported() {}
+
+func Map[T any](v T) T { return v }
+`
+
+// checkTestSource returns the type checked package of the test source
+func checkTestSource(t *testing.T) *types.Package {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "example.go", testSource, 0)
+	if err != nil {
+		t.Fatal("ParseFile error:", err)
+	}
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := config.Check("example.com/example", fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatal("Check error:", err)
+	}
+	return pkg
+}
+
+func TestGenerate(t *testing.T) {
+	pkg := checkTestSource(t)
+
+	files, err := generate(pkg, generateOptions{Package: "packages", Tags: "!appengine", Minor: 13})
+	if err != nil {
+		t.Fatal("generate error:", err)
+	}
+	if len(files) != 1 || files[0].Name != "example.com.example.go" {
+		t.Fatalf("files - received: %v - expected: [example.com.example.go]", files)
+	}
+
+	expected := `// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build !appengine
+// +build !appengine
+
+package packages
+
+import (
+	"example.com/example"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["example.com/example"] = map[string]reflect.Value{
+		"Big":      reflect.ValueOf(int64(example.Big)),
+		"Exported": reflect.ValueOf(example.Exported),
+		"Float":    reflect.ValueOf(example.Float),
+		"Negative": reflect.ValueOf(int64(example.Negative)),
+		"Reader":   reflect.ValueOf(example.Reader),
+		"Small":    reflect.ValueOf(example.Small),
+		"Typed":    reflect.ValueOf(example.Typed),
+		"Unsigned": reflect.ValueOf(uint64(example.Unsigned)),
+	}
+	env.PackageTypes["example.com/example"] = map[string]reflect.Type{
+		"Alias":     reflect.TypeOf(example.Alias{}),
+		"Duration":  reflect.TypeOf(example.Duration(0)),
+		"Flag":      reflect.TypeOf(example.Flag(false)),
+		"Func":      reflect.TypeOf((*example.Func)(nil)).Elem(),
+		"Interface": reflect.TypeOf((*example.Interface)(nil)).Elem(),
+		"Name":      reflect.TypeOf(example.Name("")),
+		"Slice":     reflect.TypeOf(example.Slice{}),
+		"Struct":    reflect.TypeOf(example.Struct{}),
+	}
+}
+`
+	if string(files[0].Source) != expected {
+		t.Errorf("generate - received:\n%s\nexpected:\n%v", files[0].Source, expected)
+	}
+}
+
+func TestGenerateVersions(t *testing.T) {
+	pkg := checkTestSource(t)
+
+	versions := goVersions{"example.com/example": {"Exported": 18, "Small": 18, "Interface": 20, "Big": 10}}
+	files, err := generate(pkg, generateOptions{Package: "packages", Versions: versions, Minor: 13, Helpers: true})
+	if err != nil {
+		t.Fatal("generate error:", err)
+	}
+
+	expected := map[string]string{
+		"example.com.exampleGo118.go": `// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.18
+// +build go1.18
+
+package packages
+
+import (
+	"example.com/example"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func exampleComExampleGo118() {
+	env.Packages["example.com/example"]["Exported"] = reflect.ValueOf(example.Exported)
+	env.Packages["example.com/example"]["Small"] = reflect.ValueOf(example.Small)
+}
+`,
+		"example.com.exampleNotGo118.go": `// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build !go1.18
+// +build !go1.18
+
+package packages
+
+func exampleComExampleGo118() {}
+`,
+		"example.com.exampleGo120.go": `// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.20
+// +build go1.20
+
+package packages
+
+import (
+	"example.com/example"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func exampleComExampleGo120() {
+	env.PackageTypes["example.com/example"]["Interface"] = reflect.TypeOf((*example.Interface)(nil)).Elem()
+}
+`,
+	}
+	var names []string
+	for _, file := range files {
+		names = append(names, file.Name)
+		if expectedSource, ok := expected[file.Name]; ok && string(file.Source) != expectedSource {
+			t.Errorf("generate %v - received:\n%s\nexpected:\n%v", file.Name, file.Source, expectedSource)
+		}
+	}
+	expectedNames := []string{"example.com.example.go", "example.com.exampleGo118.go", "example.com.exampleNotGo118.go", "example.com.exampleGo120.go", "example.com.exampleNotGo120.go"}
+	if !reflect.DeepEqual(names, expectedNames) {
+		t.Fatalf("files - received: %v - expected: %v", names, expectedNames)
+	}
+
+	main := string(files[0].Source)
+	for _, expected := range []string{
+		"\t\t\"Big\":      reflect.ValueOf(int64(example.Big)),\n",
+		"\texampleComExampleGo118()\n\texampleComExampleGo120()\n\texampleComExampleHelpers()\n}\n",
+	} {
+		if !strings.Contains(main, expected) {
+			t.Errorf("main file does not contain %q:\n%v", expected, main)
+		}
+	}
+	for _, unexpected := range []string{"Exported", "Small", "Interface"} {
+		if strings.Contains(main, "\""+unexpected+"\"") {
+			t.Errorf("main file contains %v:\n%v", unexpected, main)
+		}
+	}
+}
+
+func TestFileName(t *testing.T) {
+	for path, expected := range map[string]string{"strings": "strings.go", "net/http/cookiejar": "net.http.cookiejar.go"} {
+		if name := fileName(path); name != expected {
+			t.Errorf("fileName %v - received: %v - expected: %v", path, name, expected)
+		}
+	}
+	for path, expected := range map[string]string{"strings": "stringsHelpers.go", "net/http": "net.httpHelpers.go"} {
+		if name := helpersFileName(path); name != expected {
+			t.Errorf("helpersFileName %v - received: %v - expected: %v", path, name, expected)
+		}
+	}
+	for path, expected := range map[string]string{"strings": "stringsHelpers", "net/http": "netHttpHelpers"} {
+		if name := helpersName(path); name != expected {
+			t.Errorf("helpersName %v - received: %v - expected: %v", path, name, expected)
+		}
+	}
+}
+
+func TestGenerateExclude(t *testing.T) {
+	pkg := checkTestSource(t)
+
+	exclude, err := parseExclude("example.com/example.Exported,example.com/example.Struct,strings.Cut")
+	if err != nil {
+		t.Fatal("parseExclude error:", err)
+	}
+	files, err := generate(pkg, generateOptions{Package: "packages", Minor: 13, Exclude: exclude["example.com/example"]})
+	if err != nil {
+		t.Fatal("generate error:", err)
+	}
+	main := string(files[0].Source)
+	for _, unexpected := range []string{"\"Exported\"", "\"Struct\""} {
+		if strings.Contains(main, unexpected) {
+			t.Errorf("main file contains %v:\n%v", unexpected, main)
+		}
+	}
+	if !strings.Contains(main, "\"Alias\"") {
+		t.Errorf("main file does not contain \"Alias\":\n%v", main)
+	}
+
+	for _, symbols := range []string{"Cut", "strings.", ".Cut", "strings/Cut"} {
+		_, err = parseExclude(symbols)
+		if err == nil {
+			t.Errorf("parseExclude error - received: nil - expected: not like import/path.Name error - symbols: %v", symbols)
+		}
+	}
+}
+
+func TestLoadPackages(t *testing.T) {
+	// a package of the module, not in GOROOT
+	pkgs, err := loadPackages([]string{"github.com/mattn/anko/ast", "strings"})
+	if err != nil {
+		t.Fatal("loadPackages error:", err)
+	}
+	if len(pkgs) != 2 || pkgs[0].Path() != "github.com/mattn/anko/ast" || pkgs[1].Path() != "strings" {
+		t.Fatalf("packages - received: %v - expected: [github.com/mattn/anko/ast strings]", pkgs)
+	}
+
+	files, err := generate(pkgs[0], generateOptions{Package: "packages", Minor: 13})
+	if err != nil {
+		t.Fatal("generate error:", err)
+	}
+	if len(files) != 1 || files[0].Name != "github.com.mattn.anko.ast.go" {
+		t.Fatalf("files - received: %v - expected: [github.com.mattn.anko.ast.go]", files)
+	}
+	main := string(files[0].Source)
+	for _, expected := range []string{"\t\"github.com/mattn/anko/ast\"\n", "env.Packages[\"github.com/mattn/anko/ast\"]", "reflect.TypeOf((*ast.Stmt)(nil)).Elem()"} {
+		if !strings.Contains(main, expected) {
+			t.Errorf("main file does not contain %v:\n%v", expected, main)
+		}
+	}
+
+	_, err = loadPackages([]string{"github.com/mattn/anko/missing"})
+	if err == nil {
+		t.Error("loadPackages error - received: nil - expected: missing package error")
+	}
+}
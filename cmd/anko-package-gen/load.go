@@ -0,0 +1,77 @@
+// +build go1.18
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// listedPackage is the part of the go list -json output of a package that loadPackages uses
+type listedPackage struct {
+	ImportPath string
+	Export     string
+	Error      *struct {
+		Err string
+	}
+}
+
+// loadPackages returns the type checked packages of the import paths in the module of the working directory.
+// The export data of the packages is built by go list -export, so packages of the module and of its
+// requirements are loaded like the packages of GOROOT.
+func loadPackages(paths []string) ([]*types.Package, error) {
+	args := append([]string{"list", "-e", "-export", "-json", "--"}, paths...)
+	cmd := exec.Command("go", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	exports := make(map[string]string, len(paths))
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	for {
+		var listed listedPackage
+		err = decoder.Decode(&listed)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("go list output: %v", err)
+		}
+		if listed.Error != nil {
+			return nil, fmt.Errorf("package %v: %v", listed.ImportPath, listed.Error.Err)
+		}
+		if listed.Export == "" {
+			return nil, fmt.Errorf("package %v: no export data", listed.ImportPath)
+		}
+		exports[listed.ImportPath] = listed.Export
+	}
+
+	lookup := func(path string) (io.ReadCloser, error) {
+		export, ok := exports[path]
+		if !ok {
+			return nil, fmt.Errorf("package %v: not listed", path)
+		}
+		return os.Open(export)
+	}
+	packageImporter := importer.ForCompiler(token.NewFileSet(), "gc", lookup)
+
+	pkgs := make([]*types.Package, len(paths))
+	for i, path := range paths {
+		pkgs[i], err = packageImporter.Import(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pkgs, nil
+}
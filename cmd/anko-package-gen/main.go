@@ -1,122 +1,211 @@
+// +build go1.18
+
+// anko-package-gen generates the env.Packages and env.PackageTypes bindings of Go packages for anko scripts,
+// in the format of the files in the packages directory.
+//
+//	anko-package-gen [-o dir] [-package name] [-tags constraint] [-exclude symbols] import/path ...
+//
+// The packages are loaded with type information from the module of the working directory,
+// using the export data built by go list -export, so they can be packages of the module or of its requirements.
+// Without -o the bindings are written to stdout.
+//
+// Symbols added after the Go version of -go, by default the go directive of go.mod, are bound in version files
+// with a build tag, like strings.Cut in stringsGo118.go with go1.18 and the stub stringsNotGo118.go with !go1.18.
+// The Go version of each symbol is read from the api/go1.*.txt files of GOROOT and from the -versions table.
+//
+// The -exclude symbols, like database/sql.Register, are not bound.
+//
+// Symbols written by hand for a package are added by a helpers file in the -o directory,
+// like net.httpHelpers.go with the function netHttpHelpers, that the generated init calls after the bindings are set.
 package main
 
 import (
+	"bytes"
+	"flag"
 	"fmt"
-	"go/ast"
-	"go/parser"
-	"go/token"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"sort"
+	"regexp"
 	"strings"
 )
 
-func pkgName(f string) string {
-	file, err := parser.ParseFile(token.NewFileSet(), f, nil, parser.PackageClauseOnly)
-	if err != nil || file == nil {
-		return ""
-	}
-	return file.Name.Name
-}
+// versionFileRegexp matches the suffix of version file names, like Go118.go and NotGo118.go
+var versionFileRegexp = regexp.MustCompile(`^(Not)?Go1[0-9]+\.go$`)
 
-func isGoFile(dir os.FileInfo) bool {
-	return !dir.IsDir() &&
-		!strings.HasPrefix(dir.Name(), ".") && // ignore .files
-		filepath.Ext(dir.Name()) == ".go"
-}
+func main() {
+	flagOutput := flag.String("o", "", "write the binding of each package to a file in the directory, named like net.http.go")
+	flagPackage := flag.String("package", "packages", "package name of the generated files")
+	flagTags := flag.String("tags", "", "build constraint of the generated files, like !appengine")
+	flagGo := flag.String("go", "", "oldest Go version to build the bindings with, like 1.13, defaults to the go directive of go.mod")
+	flagAPI := flag.String("api", "", "directory of the api/go1.*.txt files, defaults to the api directory of GOROOT")
+	flagVersions := flag.String("versions", "", "table file with lines like: strings Cut go1.18, for the Go versions of symbols not in the api files")
+	flagExclude := flag.String("exclude", "", "comma separated symbols to not bind, like database/sql.Register")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: anko-package-gen [flags] import/path ...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
 
-func isPkgFile(dir os.FileInfo) bool {
-	return isGoFile(dir) && !strings.HasSuffix(dir.Name(), "_test.go") // ignore test files
-}
+	log.SetFlags(0)
+	log.SetPrefix("anko-package-gen: ")
 
-func parseDir(p string) (map[string]*ast.Package, error) {
-	isGoFile := func(d os.FileInfo) bool {
-		return !d.IsDir() && !strings.HasSuffix(d.Name(), "_test.go") && !strings.HasPrefix(d.Name(), "example_")
+	minor, err := goMinor(*flagGo)
+	if err != nil {
+		log.Fatal(err)
 	}
-
-	pkgs, err := parser.ParseDir(token.NewFileSet(), p, isGoFile, parser.ParseComments)
+	versions, err := readVersions(*flagAPI, *flagVersions)
 	if err != nil {
-		return nil, err
+		log.Fatal(err)
 	}
-	return pkgs, nil
-}
 
-func main() {
-	pkg := "flag"
-	if len(os.Args) == 2 {
-		pkg = os.Args[1]
-	}
-	b, err := exec.Command("go", "env", "GOROOT").CombinedOutput()
+	exclude, err := parseExclude(*flagExclude)
 	if err != nil {
 		log.Fatal(err)
 	}
-	paths := []string{filepath.Join(strings.TrimSpace(string(b)), "src")}
-	b, err = exec.Command("go", "env", "GOPATH").CombinedOutput()
+
+	pkgs, err := loadPackages(flag.Args())
 	if err != nil {
 		log.Fatal(err)
 	}
-	for _, p := range strings.Split(strings.TrimSpace(string(b)), string(filepath.ListSeparator)) {
-		paths = append(paths, filepath.Join(p, "src"))
-	}
-	for _, p := range paths {
-		pp := filepath.Join(p, pkg)
-		pkgs, err := parseDir(pp)
-		if err != nil || len(pkgs) == 0 {
-			continue
+	for _, pkg := range pkgs {
+		path := pkg.Path()
+		options := generateOptions{Package: *flagPackage, Tags: *flagTags, Versions: versions, Minor: minor, Exclude: exclude[path]}
+		if *flagOutput != "" {
+			options.Helpers, err = hasHelpersFile(*flagOutput, path)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+		files, err := generate(pkg, options)
+		if err != nil {
+			log.Fatal(err)
 		}
-		names := map[string]bool{}
-		pn := pkg
-		for _, pp := range pkgs {
-			pn = pp.Name
-			for _, f := range pp.Files {
-				for _, d := range f.Decls {
-					switch decl := d.(type) {
-					case *ast.GenDecl:
-						for _, spec := range decl.Specs {
-							if vspec, ok := spec.(*ast.ValueSpec); ok {
-								for _, n := range vspec.Names {
-									c := n.Name[0]
-									if c < 'A' || c > 'Z' {
-										continue
-									}
-									names[n.Name] = true
-								}
-							}
-						}
-					case *ast.FuncDecl:
-						if decl.Recv != nil {
-							continue
-						}
-						c := decl.Name.Name[0]
-						if c < 'A' || c > 'Z' {
-							continue
-						}
-						names[decl.Name.Name] = true
-					}
+
+		if *flagOutput == "" {
+			for i, file := range files {
+				if i > 0 {
+					fmt.Println()
 				}
+				fmt.Printf("// %v\n\n%s", file.Name, file.Source)
 			}
+			continue
 		}
-		keys := []string{}
-		for k := range names {
-			keys = append(keys, k)
+		err = removeVersionFiles(*flagOutput, path)
+		if err != nil {
+			log.Fatal(err)
 		}
-		sort.Strings(keys)
-		fmt.Printf(`// Package %s implements %s interface for anko script.
-package %s
+		for _, file := range files {
+			err = ioutil.WriteFile(filepath.Join(*flagOutput, file.Name), file.Source, 0644)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+}
 
-import (
-	"%s"
-)
+// goMinor returns the Go 1 minor version of the version flag or else of go.mod, 0 if none
+func goMinor(version string) (int, error) {
+	if version != "" {
+		return parseGoVersion(version)
+	}
+	minor, _ := moduleGoVersion(".")
+	return minor, nil
+}
+
+// readVersions returns the Go versions of the symbols in the api files of apiDir or GOROOT and in the table file if not empty
+func readVersions(apiDir string, table string) (goVersions, error) {
+	if apiDir == "" {
+		goroot, err := exec.Command("go", "env", "GOROOT").Output()
+		if err != nil {
+			return nil, fmt.Errorf("go env GOROOT: %v", err)
+		}
+		apiDir = filepath.Join(strings.TrimSpace(string(goroot)), "api")
+	}
+	versions := make(goVersions)
+	err := versions.readAPIDir(apiDir)
+	if err != nil {
+		return nil, err
+	}
+	if table != "" {
+		err = versions.readTable(table)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return versions, nil
+}
+
+// parseExclude returns the names of the comma separated symbols by package path, like database/sql.Register
+func parseExclude(symbols string) (map[string]map[string]struct{}, error) {
+	exclude := make(map[string]map[string]struct{})
+	if symbols == "" {
+		return exclude, nil
+	}
+	for _, symbol := range strings.Split(symbols, ",") {
+		index := strings.LastIndex(symbol, ".")
+		if index < 1 || index == len(symbol)-1 || strings.Contains(symbol[index+1:], "/") {
+			return nil, fmt.Errorf("exclude symbol %q is not like import/path.Name", symbol)
+		}
+		path := symbol[:index]
+		if exclude[path] == nil {
+			exclude[path] = make(map[string]struct{})
+		}
+		exclude[path][symbol[index+1:]] = struct{}{}
+	}
+	return exclude, nil
+}
 
-func init() {
-	Packages["%s"] = map[string]interface{}{
-`, pn, pkg, pn, pkg, pn)
-		for _, k := range keys {
-			fmt.Printf(`	"%s": %s.%s,`+"\n", k, pn, k)
+// removeVersionFiles removes the generated version files of the package path in dir,
+// so the versions files that are not generated anymore do not stay
+func removeVersionFiles(dir string, path string) error {
+	base := strings.TrimSuffix(fileName(path), ".go")
+	fileInfos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, fileInfo := range fileInfos {
+		name := fileInfo.Name()
+		if !strings.HasPrefix(name, base) || !versionFileRegexp.MatchString(name[len(base):]) {
+			continue
+		}
+		filename := filepath.Join(dir, name)
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		if !bytes.HasPrefix(data, []byte(generatedComment)) {
+			continue
+		}
+		err = os.Remove(filename)
+		if err != nil {
+			return err
 		}
-		fmt.Println(`	}
-}`)
 	}
+	return nil
+}
+
+// hasHelpersFile returns true if dir has the helpers file of the package path, like net.httpHelpers.go for net/http
+func hasHelpersFile(dir string, path string) (bool, error) {
+	_, err := os.Stat(filepath.Join(dir, helpersFileName(path)))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// helpersFileName returns the file name of the helpers of the package path, like net.httpHelpers.go for net/http
+func helpersFileName(path string) string {
+	return strings.TrimSuffix(fileName(path), ".go") + "Helpers.go"
+}
+
+// fileName returns the file name of the binding of the package path, like net.http.go for net/http
+func fileName(path string) string {
+	return strings.Replace(path, "/", ".", -1) + ".go"
 }
@@ -0,0 +1,155 @@
+// +build go1.18
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// goVersions has the Go 1 minor version that added each symbol, by import path and symbol name
+type goVersions map[string]map[string]int
+
+var (
+	// apiLineRegexp matches the symbol lines of the api/go1.*.txt files, like:
+	// pkg strings, func Cut(string, string) (string, string, bool)
+	// pkg syscall (linux-386), const AF_ALG = 38
+	apiLineRegexp = regexp.MustCompile(`^pkg ([^ ,]+)(?: \([^)]*\))?, (?:func|const|var|type) (\w+)`)
+	// apiFileRegexp matches the api file names and has the minor version, empty for go1.txt
+	apiFileRegexp = regexp.MustCompile(`^go1(?:\.(\d+))?\.txt$`)
+	// goModRegexp matches the go directive of go.mod
+	goModRegexp = regexp.MustCompile(`(?m)^go\s+(\S+)`)
+)
+
+// readAPIDir adds the symbols of the api/go1.*.txt files in dir
+func (versions goVersions) readAPIDir(dir string) error {
+	fileInfos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, fileInfo := range fileInfos {
+		matches := apiFileRegexp.FindStringSubmatch(fileInfo.Name())
+		if matches == nil {
+			continue
+		}
+		minor := 0
+		if matches[1] != "" {
+			minor, _ = strconv.Atoi(matches[1])
+		}
+		err = versions.readFile(filepath.Join(dir, fileInfo.Name()), func(line string) error {
+			matches := apiLineRegexp.FindStringSubmatch(line)
+			if matches != nil {
+				versions.add(matches[1], matches[2], minor)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readTable adds the symbols of a table file with lines like: strings Cut go1.18
+// Empty lines and lines starting with # are ignored.
+func (versions goVersions) readTable(filename string) error {
+	return versions.readFile(filename, func(line string) error {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			return nil
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return fmt.Errorf("%v: invalid line %q, expected import/path Symbol go1.N", filename, line)
+		}
+		minor, err := parseGoVersion(fields[2])
+		if err != nil {
+			return fmt.Errorf("%v: %v", filename, err)
+		}
+		if versions[fields[0]] == nil {
+			versions[fields[0]] = make(map[string]int)
+		}
+		// the table overrides the api files
+		versions[fields[0]][fields[1]] = minor
+		return nil
+	})
+}
+
+// readFile calls lineFunc for each line of the file
+func (versions goVersions) readFile(filename string, lineFunc func(line string) error) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		err = lineFunc(scanner.Text())
+		if err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// add adds the symbol with the minor version, keeping the lowest version
+func (versions goVersions) add(path string, symbol string, minor int) {
+	symbols := versions[path]
+	if symbols == nil {
+		symbols = make(map[string]int)
+		versions[path] = symbols
+	}
+	if current, ok := symbols[symbol]; !ok || minor < current {
+		symbols[symbol] = minor
+	}
+}
+
+// minor returns the minor version that added the symbol, 0 if unknown
+func (versions goVersions) minor(path string, symbol string) int {
+	return versions[path][symbol]
+}
+
+// parseGoVersion returns the minor version of a Go version like go1.18, 1.18 or 1.18.3
+func parseGoVersion(version string) (int, error) {
+	parts := strings.Split(strings.TrimPrefix(version, "go"), ".")
+	if len(parts) < 2 || parts[0] != "1" {
+		return 0, fmt.Errorf("invalid Go version %q", version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid Go version %q", version)
+	}
+	return minor, nil
+}
+
+// moduleGoVersion returns the minor version of the go directive of the go.mod of the module of dir,
+// false if there is no go.mod or go directive
+func moduleGoVersion(dir string) (int, bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return 0, false
+	}
+	for {
+		data, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			matches := goModRegexp.FindSubmatch(data)
+			if matches == nil {
+				return 0, false
+			}
+			minor, err := parseGoVersion(string(matches[1]))
+			return minor, err == nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return 0, false
+		}
+		dir = parent
+	}
+}
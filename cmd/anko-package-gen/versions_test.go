@@ -0,0 +1,53 @@
+// +build go1.18
+
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadVersions(t *testing.T) {
+	versions, err := readVersions(filepath.Join("testdata", "api"), filepath.Join("testdata", "versions.txt"))
+	if err != nil {
+		t.Fatal("readVersions error:", err)
+	}
+	expected := goVersions{
+		"strings":         {"Contains": 2, "Reader": 0, "Cut": 18},
+		"syscall":         {"AF_ALG": 0, "AF_NEW": 18},
+		"example.com/lib": {"Feature": 21},
+	}
+	if !reflect.DeepEqual(versions, expected) {
+		t.Errorf("versions - received: %v - expected: %v", versions, expected)
+	}
+	if minor := versions.minor("time", "Unix"); minor != 0 {
+		t.Errorf("minor - received: %v - expected: %v", minor, 0)
+	}
+}
+
+func TestParseGoVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		minor   int
+		err     bool
+	}{
+		{version: "go1.18", minor: 18},
+		{version: "1.13", minor: 13},
+		{version: "1.21.3", minor: 21},
+		{version: "2.0", err: true},
+		{version: "go1", err: true},
+		{version: "go1.x", err: true},
+	}
+	for _, test := range tests {
+		minor, err := parseGoVersion(test.version)
+		if (err != nil) != test.err || minor != test.minor {
+			t.Errorf("parseGoVersion %v - received: %v, %v - expected: %v, error %v", test.version, minor, err, test.minor, test.err)
+		}
+	}
+
+	minor, ok := moduleGoVersion(".")
+	if !ok || minor != 13 {
+		t.Errorf("moduleGoVersion - received: %v, %v - expected: %v, %v", minor, ok, 13, true)
+	}
+}
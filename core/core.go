@@ -2,6 +2,7 @@
 package core
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"reflect"
@@ -68,6 +69,11 @@ func Import(e *env.Env) *env.Env {
 		return typeOf.Kind().String()
 	})
 
+	// ctx returns the context of the run, as the VM passes it to Go functions when the script omits the context argument
+	e.Define("ctx", func(ctx context.Context) context.Context {
+		return ctx
+	})
+
 	e.Define("defined", func(s string) bool {
 		_, err := e.Get(s)
 		return err == nil
@@ -99,6 +105,8 @@ func Import(e *env.Env) *env.Env {
 	e.Define("println", fmt.Println)
 	e.Define("printf", fmt.Printf)
 
+	NewModules(SearchPaths()...).Define(e, "")
+
 	ImportToX(e)
 
 	return e
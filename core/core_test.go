@@ -1,37 +1,31 @@
 package core
 
 import (
+	"context"
 	"fmt"
-	"os"
 	"reflect"
 	"strings"
 	"testing"
 
-	"github.com/mattn/anko/packages"
+	"github.com/mattn/anko/env"
+	_ "github.com/mattn/anko/packages"
+	"github.com/mattn/anko/testkit"
 	"github.com/mattn/anko/vm"
 )
 
-var testCoreEnvSetupFunc = func(t *testing.T, env corelib.Env) { Import(env.(*vm.Env)) }
-
-func init() {
-	corelib.NewEnv = func() corelib.Env {
-		return vm.NewEnv()
-	}
-}
+var testCoreEnvSetupFunc = func(t *testing.T, e *env.Env) { Import(e) }
 
 func TestKeys(t *testing.T) {
-	os.Setenv("ANKO_DEBUG", "1")
-	tests := []testlib.Test{
+	tests := []testkit.Test{
 		{Script: `a = {}; b = keys(a)`, RunOutput: []interface{}{}, Output: map[string]interface{}{"a": map[interface{}]interface{}{}}},
 		{Script: `a = {"a": nil}; b = keys(a)`, RunOutput: []interface{}{"a"}, Output: map[string]interface{}{"a": map[interface{}]interface{}{"a": nil}}},
 		{Script: `a = {"a": 1}; b = keys(a)`, RunOutput: []interface{}{"a"}, Output: map[string]interface{}{"a": map[interface{}]interface{}{"a": int64(1)}}},
 	}
-	testlib.Run(t, tests, &testlib.Options{EnvSetupFunc: &testCoreEnvSetupFunc})
+	testkit.Run(t, tests, &testkit.Options{EnvSetupFunc: &testCoreEnvSetupFunc, VMOptions: &vm.Options{Debug: true}})
 }
 
 func TestKindOf(t *testing.T) {
-	os.Setenv("ANKO_DEBUG", "1")
-	tests := []testlib.Test{
+	tests := []testkit.Test{
 		{Script: `kindOf(a)`, Input: map[string]interface{}{"a": reflect.Value{}}, RunOutput: "struct", Output: map[string]interface{}{"a": reflect.Value{}}},
 		{Script: `kindOf(a)`, Input: map[string]interface{}{"a": nil}, RunOutput: "nil", Output: map[string]interface{}{"a": nil}},
 		{Script: `kindOf(a)`, Input: map[string]interface{}{"a": true}, RunOutput: "bool", Output: map[string]interface{}{"a": true}},
@@ -58,12 +52,11 @@ func TestKindOf(t *testing.T) {
 
 		{Script: `a = make(interface); kindOf(a)`, RunOutput: "nil", Output: map[string]interface{}{"a": interface{}(nil)}},
 	}
-	testlib.Run(t, tests, &testlib.Options{EnvSetupFunc: &testCoreEnvSetupFunc})
+	testkit.Run(t, tests, &testkit.Options{EnvSetupFunc: &testCoreEnvSetupFunc, VMOptions: &vm.Options{Debug: true}})
 }
 
 func TestRange(t *testing.T) {
-	os.Setenv("ANKO_DEBUG", "")
-	tests := []testlib.Test{
+	tests := []testkit.Test{
 		// 0 arguments
 		{Script: `range()`, RunError: fmt.Errorf("range expected at least 1 argument, got 0")},
 		// 1 arguments(step == 1, start == 0)
@@ -97,32 +90,25 @@ func TestRange(t *testing.T) {
 		{Script: `range(1,0,2)`, RunOutput: []int64{}},
 		{Script: `range(1,2,0)`, RunError: fmt.Errorf("range argument 3 must not be zero")},
 	}
-	testlib.Run(t, tests, &testlib.Options{EnvSetupFunc: &testCoreEnvSetupFunc})
+	testkit.Run(t, tests, &testkit.Options{EnvSetupFunc: &testCoreEnvSetupFunc})
 }
 
 func TestLoad(t *testing.T) {
-	os.Setenv("ANKO_DEBUG", "")
 	notFoundRunErrorFunc := func(t *testing.T, err error) {
 		if err == nil || !strings.HasPrefix(err.Error(), "open testdata/not-found.ank:") {
 			t.Errorf("load not-found.ank failed - received: %v", err)
 		}
 	}
-	tests := []testlib.Test{
+	tests := []testkit.Test{
 		{Script: `load('testdata/test.ank'); X(1)`, RunOutput: int64(2)},
 		{Script: `load('testdata/not-found.ank'); X(1)`, RunErrorFunc: &notFoundRunErrorFunc},
 		{Script: `load('testdata/broken.ank'); X(1)`, RunError: fmt.Errorf("syntax error")},
 	}
-	testlib.Run(t, tests, &testlib.Options{EnvSetupFunc: &testCoreEnvSetupFunc})
+	testkit.Run(t, tests, &testkit.Options{EnvSetupFunc: &testCoreEnvSetupFunc})
 }
 
 func TestAnk(t *testing.T) {
-	os.Setenv("ANKO_DEBUG", "")
-	var testEnvSetupFunc = func(t *testing.T, env corelib.Env) {
-		e := env.(*vm.Env)
-		Import(e)
-		packages.DefineImport(e)
-	}
-	tests := []testlib.Test{
+	tests := []testkit.Test{
 		{Script: `load('testdata/testing.ank'); load('testdata/let.ank')`},
 		{Script: `load('testdata/testing.ank'); load('testdata/toString.ank')`},
 		{Script: `load('testdata/testing.ank'); load('testdata/op.ank')`},
@@ -134,16 +120,53 @@ func TestAnk(t *testing.T) {
 		{Script: `load('testdata/testing.ank'); load('testdata/toBytes.ank')`},
 		{Script: `load('testdata/testing.ank'); load('testdata/toRunes.ank')`},
 		{Script: `load('testdata/testing.ank'); load('testdata/chan.ank')`},
+		{Script: `load('testdata/testing.ank'); load('testdata/sort.ank')`},
 	}
-	testlib.Run(t, tests, &testlib.Options{EnvSetupFunc: &testEnvSetupFunc})
+	testkit.Run(t, tests, &testkit.Options{EnvSetupFunc: &testCoreEnvSetupFunc})
+}
+
+func TestImport(t *testing.T) {
+	// import is part of the VM and the packages register their bindings when imported, so the core env can import them
+	tests := []testkit.Test{
+		{Script: `strings = import("strings"); strings.ToUpper("a")`, RunOutput: "A"},
+		{Script: `load('testdata/testing.ank'); regexp.MustCompile("^a").MatchString("ab")`, RunOutput: true},
+		{Script: `import("not-found")`, RunError: fmt.Errorf("package not found: not-found")},
+	}
+	testkit.Run(t, tests, &testkit.Options{EnvSetupFunc: &testCoreEnvSetupFunc})
 }
 
 func TestDefined(t *testing.T) {
-	os.Setenv("ANKO_DEBUG", "")
-	tests := []testlib.Test{
+	tests := []testkit.Test{
 		{Script: `var a = 1; defined("a")`, RunOutput: true},
 		{Script: `defined("a")`, RunOutput: false},
 		{Script: `func(){ var a = 1 }(); defined("a")`, RunOutput: false},
 	}
-	testlib.Run(t, tests, &testlib.Options{EnvSetupFunc: &testCoreEnvSetupFunc})
+	testkit.Run(t, tests, &testkit.Options{EnvSetupFunc: &testCoreEnvSetupFunc})
+}
+
+func TestCtx(t *testing.T) {
+	type key string
+	ctx := context.WithValue(context.Background(), key("a"), "b")
+	e := env.NewEnv()
+	Import(e)
+	err := e.Define("key", key("a"))
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	value, err := vm.ExecuteContext(ctx, e, nil, `ctx().Value(key)`)
+	if err != nil {
+		t.Fatal("ExecuteContext error:", err)
+	}
+	if value != "b" {
+		t.Errorf("ctx value - received: %#v - expected: %#v", value, "b")
+	}
+
+	value, err = vm.ExecuteContext(ctx, e, nil, `func a() { return ctx() }; a().Value(key)`)
+	if err != nil {
+		t.Fatal("ExecuteContext error:", err)
+	}
+	if value != "b" {
+		t.Errorf("ctx value in function - received: %#v - expected: %#v", value, "b")
+	}
 }
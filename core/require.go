@@ -0,0 +1,270 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/vm"
+)
+
+// Modules loads the modules of the require builtin. It is safe for concurrent use.
+//
+// require(name) returns an env with the symbols the module exports, like util = require("lib/util"); util.f().
+// A name starting with ./ or ../ is relative to the directory of the requiring file, an absolute name is used as is,
+// and another name is searched in the directory of the requiring file and then in Paths. The extension .ank is added
+// to a name without extension.
+//
+// Each module file runs once in its own module env and the result is cached, including errors,
+// except when the context of the require call stops the module, then the next require runs it again.
+// A module can call export("name", ...) to export only some symbols, else all symbols it defines are exported.
+// The exports are the values when the module finished running, in an env that can not be changed.
+// A require of a module that is loading in the same require chain is a circular require error.
+// A module runs with the context of the require call, so the timeout or cancel of the requiring script stops it.
+type Modules struct {
+	// Paths are the directories to search the modules in, after the directory of the requiring file.
+	Paths []string
+	// EnvSetupFunc sets up the env that the module envs are created in. Defaults to Import.
+	EnvSetupFunc func(*env.Env)
+	// Options are the VM options to run the modules with, usually the options of the requiring script.
+	// The Coverage of Options is not used, as it counts the statements of one file.
+	// A Profiler profiles the lines of the modules as lines of its file name.
+	Options *vm.Options
+	// CoverageFunc returns the coverage to count the run of the module file with, if not nil.
+	CoverageFunc func(file string, source string, stmt ast.Stmt) *vm.Coverage
+
+	mutex   sync.Mutex
+	baseEnv *env.Env
+	modules map[string]*module
+}
+
+// module is a loaded or loading module file
+type module struct {
+	file string
+	// parent is the module that required it first, nil for a require from a non module script
+	parent  *module
+	done    chan struct{}
+	exports *env.Env
+	err     error
+	// canceled is true if the context of the require stopped the module, which is then not cached
+	canceled bool
+}
+
+// NewModules returns new Modules that search the modules in paths.
+func NewModules(paths ...string) *Modules {
+	return &Modules{Paths: paths, modules: make(map[string]*module)}
+}
+
+// SearchPaths returns the module search paths in the ANKO_PATH environment variable.
+func SearchPaths() []string {
+	return filepath.SplitList(os.Getenv("ANKO_PATH"))
+}
+
+// Define defines require in e, with dir as the directory of the requiring file.
+// An empty dir is the working directory.
+func (modules *Modules) Define(e *env.Env, dir string) error {
+	return modules.define(e, dir, nil)
+}
+
+// Require returns the exports of the module name, required from a file in dir.
+// The module runs with ctx the first time it is required.
+func (modules *Modules) Require(ctx context.Context, dir string, name string) (*env.Env, error) {
+	return modules.require(ctx, dir, name, nil)
+}
+
+// define defines require in e for the module from, nil if e is not a module env
+func (modules *Modules) define(e *env.Env, dir string, from *module) error {
+	// the VM passes the context of the run
+	return e.Define("require", func(ctx context.Context, name string) *env.Env {
+		exports, err := modules.require(ctx, dir, name, from)
+		if err != nil {
+			panic(err)
+		}
+		return exports
+	})
+}
+
+// require returns the exports of the module name, loading it the first time
+func (modules *Modules) require(ctx context.Context, dir string, name string, from *module) (*env.Env, error) {
+	file, err := modules.resolve(dir, name)
+	if err != nil {
+		return nil, err
+	}
+
+	modules.mutex.Lock()
+	m, ok := modules.modules[file]
+	if ok {
+		modules.mutex.Unlock()
+		select {
+		case <-m.done:
+			return modules.loaded(ctx, dir, name, from, m)
+		default:
+		}
+		for loading := from; loading != nil; loading = loading.parent {
+			if loading == m {
+				return nil, fmt.Errorf("circular require: %v", requireChain(from, file))
+			}
+		}
+		select {
+		case <-m.done:
+			return modules.loaded(ctx, dir, name, from, m)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	m = &module{file: file, parent: from, done: make(chan struct{})}
+	modules.modules[file] = m
+	modules.mutex.Unlock()
+
+	m.exports, m.err = modules.load(ctx, m)
+	if m.err != nil && ctx.Err() != nil {
+		m.canceled = true
+		modules.mutex.Lock()
+		delete(modules.modules, file)
+		modules.mutex.Unlock()
+	}
+	close(m.done)
+	return m.exports, m.err
+}
+
+// loaded returns the exports of the loaded module m, or requires it again if the load was canceled
+func (modules *Modules) loaded(ctx context.Context, dir string, name string, from *module, m *module) (*env.Env, error) {
+	if m.canceled {
+		return modules.require(ctx, dir, name, from)
+	}
+	return m.exports, m.err
+}
+
+// resolve returns the clean absolute file name of the module name required from a file in dir
+func (modules *Modules) resolve(dir string, name string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("require: empty module name")
+	}
+	file := filepath.FromSlash(name)
+	if filepath.Ext(file) == "" {
+		file += ".ank"
+	}
+
+	var dirs []string
+	switch {
+	case filepath.IsAbs(file):
+		dirs = []string{""}
+	case strings.HasPrefix(name, "./") || strings.HasPrefix(name, "../"):
+		dirs = []string{dir}
+	default:
+		dirs = append([]string{dir}, modules.Paths...)
+	}
+
+	for _, dir := range dirs {
+		path, err := filepath.Abs(filepath.Join(dir, file))
+		if err != nil {
+			return "", err
+		}
+		info, err := os.Stat(path)
+		if err == nil && info.Mode().IsRegular() {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("require %v: module not found", name)
+}
+
+// load runs the module file in a new module env and returns its exports
+func (modules *Modules) load(ctx context.Context, m *module) (*env.Env, error) {
+	source, err := ioutil.ReadFile(m.file)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := parser.ParseSrc(string(source))
+	if err != nil {
+		if pe, ok := err.(*parser.Error); ok {
+			return nil, fmt.Errorf("%v:%v:%v: %v", m.file, pe.Pos.Line, pe.Pos.Column, pe.Message)
+		}
+		return nil, err
+	}
+
+	// require and export are defined in the scope above the module env so they are not exported
+	scope := modules.getBaseEnv().NewEnv()
+	err = modules.define(scope, filepath.Dir(m.file), m)
+	if err != nil {
+		return nil, err
+	}
+	var exportMutex sync.Mutex
+	var exportNames []string
+	scope.Define("export", func(names ...string) {
+		exportMutex.Lock()
+		exportNames = append(exportNames, names...)
+		exportMutex.Unlock()
+	})
+	moduleEnv, err := scope.NewModule("module")
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vm.RunContext(ctx, moduleEnv, modules.runOptions(m.file, string(source), stmt), stmt)
+	if err != nil {
+		if ve, ok := err.(*vm.Error); ok {
+			return nil, fmt.Errorf("%v:%v:%v: %v", m.file, ve.Pos.Line, ve.Pos.Column, ve.Message)
+		}
+		return nil, fmt.Errorf("%v: %v", m.file, err)
+	}
+
+	exportMutex.Lock()
+	defer exportMutex.Unlock()
+	if exportNames == nil {
+		exportNames = moduleEnv.Symbols()
+	}
+	exports := env.NewEnv()
+	for _, name := range exportNames {
+		value, err := moduleEnv.GetValue(name)
+		if err != nil || moduleEnv.ScopeDepth(name) != 0 {
+			return nil, fmt.Errorf("%v: export of undefined symbol '%v'", m.file, name)
+		}
+		exports.DefineValue(name, value)
+	}
+	exports.Freeze()
+	return exports, nil
+}
+
+// runOptions returns the VM options to run the module file with
+func (modules *Modules) runOptions(file string, source string, stmt ast.Stmt) *vm.Options {
+	options := &vm.Options{}
+	if modules.Options != nil {
+		*options = *modules.Options
+	}
+	options.Coverage = nil
+	if modules.CoverageFunc != nil {
+		options.Coverage = modules.CoverageFunc(file, source, stmt)
+	}
+	return options
+}
+
+// getBaseEnv returns the env that the module envs are created in
+func (modules *Modules) getBaseEnv() *env.Env {
+	modules.mutex.Lock()
+	defer modules.mutex.Unlock()
+	if modules.baseEnv == nil {
+		modules.baseEnv = env.NewEnv()
+		if modules.EnvSetupFunc != nil {
+			modules.EnvSetupFunc(modules.baseEnv)
+		} else {
+			Import(modules.baseEnv)
+		}
+	}
+	return modules.baseEnv
+}
+
+// requireChain returns the files of the require chain from the root to from and then file
+func requireChain(from *module, file string) string {
+	files := []string{file}
+	for loading := from; loading != nil; loading = loading.parent {
+		files = append([]string{loading.file}, files...)
+	}
+	return strings.Join(files, " -> ")
+}
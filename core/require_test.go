@@ -0,0 +1,198 @@
+package core
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/vm"
+)
+
+// newTestModules returns Modules for testdata/modules and the loaded module names
+func newTestModules() (*Modules, *env.Env, func() []string) {
+	var mutex sync.Mutex
+	var loaded []string
+	modules := NewModules(filepath.Join("testdata", "modules", "path"))
+	modules.EnvSetupFunc = func(e *env.Env) {
+		Import(e)
+		e.Define("loaded", func(name string) {
+			mutex.Lock()
+			loaded = append(loaded, name)
+			mutex.Unlock()
+		})
+	}
+	e := env.NewEnv()
+	Import(e)
+	modules.Define(e, filepath.Join("testdata", "modules"))
+	return modules, e, func() []string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]string(nil), loaded...)
+	}
+}
+
+func TestRequire(t *testing.T) {
+	_, e, loaded := newTestModules()
+
+	value, err := vm.Execute(e, nil, `
+u = require("lib/util")
+u2 = require("./lib/util.ank")
+[u.double(2), u.name, u == u2, require("answer").value]
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	expected := []interface{}{int64(4), "util", true, int64(42)}
+	if !reflect.DeepEqual(value, expected) {
+		t.Errorf("value - received: %#v - expected: %#v", value, expected)
+	}
+	if !reflect.DeepEqual(loaded(), []string{"helper", "util"}) {
+		t.Errorf("loaded - received: %v - expected: %v", loaded(), []string{"helper", "util"})
+	}
+
+	tests := []struct {
+		script string
+		err    string
+	}{
+		{script: `require("lib/util").secret`, err: "undefined symbol 'secret'"},
+		{script: `require("lib/util").helper`, err: "undefined symbol 'helper'"},
+		{script: `u = require("lib/util"); u.name = "x"`, err: env.ErrFrozen.Error()},
+		{script: `require("missing")`, err: "require missing: module not found"},
+		{script: `require("./answer")`, err: "require ./answer: module not found"},
+		{script: `require("cycle/a")`, err: "circular require: "},
+		{script: `require("badExport")`, err: "export of undefined symbol 'missing'"},
+	}
+	for _, test := range tests {
+		_, err := vm.Execute(e, nil, test.script)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("Execute %v error - received: %v - expected: %v", test.script, err, test.err)
+		}
+	}
+
+	_, err = vm.Execute(e, nil, `require("cycle/a")`)
+	a, _ := filepath.Abs(filepath.Join("testdata", "modules", "cycle", "a.ank"))
+	b, _ := filepath.Abs(filepath.Join("testdata", "modules", "cycle", "b.ank"))
+	chain := a + " -> " + b + " -> " + a
+	if err == nil || !strings.Contains(err.Error(), chain) {
+		t.Errorf("circular require error - received: %v - expected: %v", err, chain)
+	}
+}
+
+func TestRequireConcurrent(t *testing.T) {
+	modules, _, loaded := newTestModules()
+
+	var waitGroup sync.WaitGroup
+	exports := make([]*env.Env, 10)
+	errs := make([]error, 10)
+	for i := range exports {
+		waitGroup.Add(1)
+		go func(i int) {
+			defer waitGroup.Done()
+			exports[i], errs[i] = modules.Require(context.Background(), filepath.Join("testdata", "modules"), "lib/util")
+		}(i)
+	}
+	waitGroup.Wait()
+
+	for i := range exports {
+		if errs[i] != nil {
+			t.Fatal("Require error:", errs[i])
+		}
+		if exports[i] != exports[0] {
+			t.Errorf("exports %v is not the cached exports", i)
+		}
+	}
+	if !reflect.DeepEqual(loaded(), []string{"helper", "util"}) {
+		t.Errorf("loaded - received: %v - expected: %v", loaded(), []string{"helper", "util"})
+	}
+}
+
+func TestRequireContext(t *testing.T) {
+	_, e, _ := newTestModules()
+
+	// the timeout of the requiring script stops the module
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := vm.ExecuteContext(ctx, e, nil, `require("loop")`)
+	if err == nil || !strings.HasSuffix(err.Error(), vm.ErrInterrupt.Error()) {
+		t.Errorf("require error - received: %v - expected: %v", err, vm.ErrInterrupt)
+	}
+}
+
+func TestRequireCanceled(t *testing.T) {
+	modules, e, loaded := newTestModules()
+	envSetupFunc := modules.EnvSetupFunc
+	var waits int32
+	modules.EnvSetupFunc = func(e *env.Env) {
+		envSetupFunc(e)
+		// the first wait waits for the end of the run
+		e.Define("wait", func(ctx context.Context) {
+			if atomic.AddInt32(&waits, 1) == 1 {
+				<-ctx.Done()
+			}
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := vm.ExecuteContext(ctx, e, nil, `require("wait")`)
+	if err == nil || !strings.HasSuffix(err.Error(), vm.ErrInterrupt.Error()) {
+		t.Errorf("require error - received: %v - expected: %v", err, vm.ErrInterrupt)
+	}
+
+	// the canceled module is not cached, so it runs again with the context of the next require
+	value, err := vm.Execute(e, nil, `require("wait").a`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if value != int64(1) {
+		t.Errorf("require value - received: %#v - expected: %#v", value, int64(1))
+	}
+	if names := loaded(); !reflect.DeepEqual(names, []string{"wait", "wait"}) {
+		t.Errorf("loaded - received: %v - expected: %v", names, []string{"wait", "wait"})
+	}
+}
+
+// testRequireTracer counts the traced statements
+type testRequireTracer struct {
+	mutex      sync.Mutex
+	statements int
+}
+
+func (tracer *testRequireTracer) Trace(ctx context.Context, event *vm.TraceEvent) {
+	if event.Kind != vm.TraceStmtEnter {
+		return
+	}
+	tracer.mutex.Lock()
+	tracer.statements++
+	tracer.mutex.Unlock()
+}
+
+func TestRequireOptions(t *testing.T) {
+	modules, e, _ := newTestModules()
+	tracer := &testRequireTracer{}
+	modules.Options = &vm.Options{Tracer: tracer}
+	var files []string
+	modules.CoverageFunc = func(file string, source string, stmt ast.Stmt) *vm.Coverage {
+		files = append(files, filepath.Base(file))
+		return vm.NewCoverage()
+	}
+
+	_, err := vm.Execute(e, nil, `require("lib/util")`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	// the statements of the modules are traced, not the statement of the requiring script that runs without tracer
+	if tracer.statements == 0 {
+		t.Errorf("traced statements - received: %v - expected: more than 0", tracer.statements)
+	}
+	if !reflect.DeepEqual(files, []string{"util.ank", "helper.ank"}) {
+		t.Errorf("coverage files - received: %v - expected: %v", files, []string{"util.ank", "helper.ank"})
+	}
+}
@@ -2,16 +2,15 @@ package core
 
 import (
 	"fmt"
-	"os"
 	"testing"
 	"time"
 
-	"github.com/mattn/anko/internal/testlib"
+	"github.com/mattn/anko/testkit"
+	"github.com/mattn/anko/vm"
 )
 
 func TestToX(t *testing.T) {
-	os.Setenv("ANKO_DEBUG", "1")
-	tests := []testlib.Test{
+	tests := []testkit.Test{
 		{Script: `toBool(-2)`, RunOutput: false},
 		{Script: `toBool(-1.5)`, RunOutput: false},
 		{Script: `toBool(-1)`, RunOutput: false},
@@ -147,5 +146,5 @@ func TestToX(t *testing.T) {
 		{Script: `toDuration(a)`, Input: map[string]interface{}{"a": float64(time.Duration(123 * time.Minute))}, RunOutput: time.Duration(123 * time.Minute)},
 		{Script: `toDuration(a)`, Input: map[string]interface{}{"a": time.Duration(123 * time.Minute)}, RunOutput: time.Duration(123 * time.Minute)},
 	}
-	testlib.Run(t, tests, &testlib.Options{EnvSetupFunc: &testCoreEnvSetupFunc})
+	testkit.Run(t, tests, &testkit.Options{EnvSetupFunc: &testCoreEnvSetupFunc, VMOptions: &vm.Options{Debug: true}})
 }
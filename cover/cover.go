@@ -0,0 +1,145 @@
+// Package cover collects the statement and branch coverage of anko script files
+// and reports it as text, as HTML with the annotated source and in the Go cover profile format.
+//
+//	profile := cover.NewProfile()
+//	coverage := profile.Coverage("rules.ank", source, stmt)
+//	_, err := vm.Run(e, &vm.Options{Coverage: coverage}, stmt)
+//	...
+//	err = cover.WriteText(os.Stdout, profile)
+package cover
+
+import (
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/ast/astutil"
+	"github.com/mattn/anko/vm"
+)
+
+// Profile is the coverage of script files. It can be used by many runs at the same time.
+type Profile struct {
+	mutex sync.Mutex
+	files map[string]*File
+}
+
+// File is the coverage of a script file.
+type File struct {
+	// Name is the file name.
+	Name string
+	// Source is the script source.
+	Source string
+	// Coverage is the coverage of the script.
+	Coverage *vm.Coverage
+}
+
+// Summary is the number of statements and branch outcomes and how many of them are covered.
+type Summary struct {
+	Statements        int
+	CoveredStatements int
+	Branches          int
+	CoveredBranches   int
+}
+
+// NewProfile returns a new Profile.
+func NewProfile() *Profile {
+	return &Profile{files: make(map[string]*File)}
+}
+
+// Coverage returns the coverage of the script file to set in vm.Options.
+// The first time for a file name, the file is added with all statements and branches of stmt at zero counts.
+func (profile *Profile) Coverage(name string, source string, stmt ast.Stmt) *vm.Coverage {
+	name = filepath.Clean(name)
+
+	profile.mutex.Lock()
+	defer profile.mutex.Unlock()
+
+	file, ok := profile.files[name]
+	if !ok {
+		file = &File{Name: name, Source: source, Coverage: vm.NewCoverage()}
+		AddNodes(file.Coverage, stmt)
+		profile.files[name] = file
+	}
+	return file.Coverage
+}
+
+// Files returns the files sorted by name.
+func (profile *Profile) Files() []*File {
+	profile.mutex.Lock()
+	files := make([]*File, 0, len(profile.files))
+	for _, file := range profile.files {
+		files = append(files, file)
+	}
+	profile.mutex.Unlock()
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	return files
+}
+
+// Merge adds the files and counts of other to profile.
+func (profile *Profile) Merge(other *Profile) {
+	for _, otherFile := range other.Files() {
+		profile.mutex.Lock()
+		file, ok := profile.files[otherFile.Name]
+		if !ok {
+			file = &File{Name: otherFile.Name, Source: otherFile.Source, Coverage: vm.NewCoverage()}
+			profile.files[otherFile.Name] = file
+		}
+		profile.mutex.Unlock()
+		file.Coverage.Merge(otherFile.Coverage)
+	}
+}
+
+// Summary returns the summary of all files.
+func (profile *Profile) Summary() Summary {
+	var summary Summary
+	for _, file := range profile.Files() {
+		fileSummary := file.Summary()
+		summary.Statements += fileSummary.Statements
+		summary.CoveredStatements += fileSummary.CoveredStatements
+		summary.Branches += fileSummary.Branches
+		summary.CoveredBranches += fileSummary.CoveredBranches
+	}
+	return summary
+}
+
+// Summary returns the summary of the file.
+func (file *File) Summary() Summary {
+	var summary Summary
+	for _, statement := range file.Coverage.Statements() {
+		summary.Statements++
+		if statement.Count > 0 {
+			summary.CoveredStatements++
+		}
+	}
+	for _, branch := range file.Coverage.Branches() {
+		for _, count := range branch.Counts {
+			summary.Branches++
+			if count > 0 {
+				summary.CoveredBranches++
+			}
+		}
+	}
+	return summary
+}
+
+// AddNodes adds the statements and branches of stmt to coverage with zero counts.
+func AddNodes(coverage *vm.Coverage, stmt ast.Stmt) {
+	// else if statements are run as part of their if statement
+	elseIfs := make(map[ast.Stmt]struct{})
+	astutil.Walk(stmt, func(node interface{}) error {
+		if ifStmt, ok := node.(*ast.IfStmt); ok {
+			if _, ok := elseIfs[ifStmt]; ok {
+				return nil
+			}
+			for _, elseIf := range ifStmt.ElseIf {
+				elseIfs[elseIf] = struct{}{}
+			}
+		}
+		coverage.AddNode(node)
+		return nil
+	})
+}
@@ -0,0 +1,135 @@
+package cover
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/vm"
+)
+
+const testSource = `func f(s) {
+	if s == "" {
+		return "empty"
+	}
+	return s ?? "é"; s = "ü"
+}
+g = func() { return 1 }
+f("a")
+`
+
+// runCovered runs the test source with coverage in profile
+func runCovered(t *testing.T, profile *Profile) {
+	stmt, err := parser.ParseSrc(testSource)
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	_, err = vm.Run(env.NewEnv(), &vm.Options{Coverage: profile.Coverage("./test.ank", testSource, stmt)}, stmt)
+	if err != nil {
+		t.Fatal("Run error:", err)
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	profile := NewProfile()
+	runCovered(t, profile)
+
+	var buffer bytes.Buffer
+	err := WriteText(&buffer, profile)
+	if err != nil {
+		t.Fatal("WriteText error:", err)
+	}
+	expected := `test.ank:2:2: if then not taken
+test.ank:3:3: statement not run
+test.ank:5:9: ?? right not taken
+test.ank:5:19: statement not run
+test.ank:7:14: statement not run
+test.ank	statements 62.5% (5/8)	branches 50.0% (2/4)
+total	statements 62.5% (5/8)	branches 50.0% (2/4)
+`
+	if buffer.String() != expected {
+		t.Errorf("WriteText - received:\n%v - expected:\n%v", buffer.String(), expected)
+	}
+
+	buffer.Reset()
+	err = WriteText(&buffer, NewProfile())
+	if err != nil {
+		t.Fatal("WriteText error:", err)
+	}
+	if buffer.String() != "total\t[no statements]\t[no branches]\n" {
+		t.Errorf("WriteText - received: %q - expected: %q", buffer.String(), "total\t[no statements]\t[no branches]\n")
+	}
+}
+
+func TestWriteGoProfile(t *testing.T) {
+	profile := NewProfile()
+	runCovered(t, profile)
+
+	var buffer bytes.Buffer
+	err := WriteGoProfile(&buffer, profile)
+	if err != nil {
+		t.Fatal("WriteGoProfile error:", err)
+	}
+	// columns are byte columns, é is two bytes
+	expected := `mode: count
+test.ank:1.1,1.12 1 1
+test.ank:2.2,2.14 1 1
+test.ank:3.3,3.17 1 0
+test.ank:5.2,5.20 1 1
+test.ank:5.20,5.28 1 0
+test.ank:7.1,7.14 1 1
+test.ank:7.14,7.24 1 0
+test.ank:8.1,8.7 1 1
+`
+	if buffer.String() != expected {
+		t.Errorf("WriteGoProfile - received:\n%v - expected:\n%v", buffer.String(), expected)
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	profile := NewProfile()
+	runCovered(t, profile)
+
+	var buffer bytes.Buffer
+	err := WriteHTML(&buffer, profile)
+	if err != nil {
+		t.Fatal("WriteHTML error:", err)
+	}
+	html := buffer.String()
+	for _, expected := range []string{
+		`<h2>test.ank - statements 62.5% (5/8), branches 50.0% (2/4)</h2>`,
+		"<span class=\"line partial\" title=\"2:2 run 1 times\n2:2 if: then 0, else 1\"><span class=\"number\">2</span>\tif s == &#34;&#34; {</span>",
+		`<span class="line uncovered" title="3:3 run 0 times"><span class="number">3</span>		return &#34;empty&#34;</span>`,
+		`<span class="line"><span class="number">4</span>	}</span>`,
+		`<span class="line covered" title="8:1 run 1 times"><span class="number">8</span>f(&#34;a&#34;)</span>`,
+	} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("WriteHTML does not contain %v - output:\n%v", expected, html)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	profile := NewProfile()
+	runCovered(t, profile)
+	other := NewProfile()
+	runCovered(t, other)
+	runCovered(t, other)
+	profile.Merge(other)
+
+	files := profile.Files()
+	if len(files) != 1 || files[0].Name != "test.ank" {
+		t.Fatalf("files - received: %v - expected: [test.ank]", files)
+	}
+	statements := files[0].Coverage.Statements()
+	if statements[0].Count != 3 {
+		t.Errorf("merged count - received: %v - expected: %v", statements[0].Count, 3)
+	}
+	summary := profile.Summary()
+	expected := Summary{Statements: 8, CoveredStatements: 5, Branches: 4, CoveredBranches: 2}
+	if summary != expected {
+		t.Errorf("summary - received: %+v - expected: %+v", summary, expected)
+	}
+}
@@ -0,0 +1,228 @@
+package cover
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/mattn/anko/vm"
+)
+
+// htmlLine is a source line of the HTML report
+type htmlLine struct {
+	Number int
+	Text   string
+	Class  string
+	Title  string
+}
+
+// htmlFile is a file of the HTML report
+type htmlFile struct {
+	Name    string
+	Summary string
+	Lines   []htmlLine
+}
+
+// htmlTemplate is the template of the HTML report
+var htmlTemplate = template.Must(template.New("cover").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>anko coverage</title>
+<style>
+body { background: #fff; color: #222; font-family: sans-serif; }
+h2 { font-size: 16px; }
+pre { font-family: Menlo, Consolas, monospace; font-size: 13px; }
+.line { display: block; white-space: pre; }
+.number { color: #999; display: inline-block; width: 4em; margin-right: 1em; text-align: right; }
+.covered { background: #dfd; }
+.uncovered { background: #fdd; }
+.partial { background: #ffc; }
+</style>
+</head>
+<body>
+<h1>anko coverage - {{.Summary}}</h1>
+{{- range .Files}}
+<h2>{{.Name}} - {{.Summary}}</h2>
+<pre>
+{{- range .Lines}}<span class="line{{if .Class}} {{.Class}}{{end}}"{{if .Title}} title="{{.Title}}"{{end}}><span class="number">{{.Number}}</span>{{.Text}}</span>{{end -}}
+</pre>
+{{- end}}
+</body>
+</html>
+`))
+
+// WriteText writes the statements that did not run and the branch outcomes that were not taken
+// as file:line:column: message lines, followed by the summary of each file and the total.
+func WriteText(w io.Writer, profile *Profile) error {
+	files := profile.Files()
+	for _, file := range files {
+		statements := file.Coverage.Statements()
+		branches := file.Coverage.Branches()
+		i, j := 0, 0
+		for i < len(statements) || j < len(branches) {
+			var err error
+			if j >= len(branches) || (i < len(statements) && !positionAfter(statements[i].Pos.Line, statements[i].Pos.Column, branches[j].Pos.Line, branches[j].Pos.Column)) {
+				statement := statements[i]
+				i++
+				if statement.Count > 0 {
+					continue
+				}
+				_, err = fmt.Fprintf(w, "%v:%v:%v: statement not run\n", file.Name, statement.Pos.Line, statement.Pos.Column)
+			} else {
+				branch := branches[j]
+				j++
+				notTaken := notTakenOutcomes(branch)
+				if len(notTaken) == 0 {
+					continue
+				}
+				_, err = fmt.Fprintf(w, "%v:%v:%v: %v %v not taken\n", file.Name, branch.Pos.Line, branch.Pos.Column, branch.Kind, strings.Join(notTaken, ", "))
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, file := range files {
+		_, err := fmt.Fprintf(w, "%v\t%v\n", file.Name, summaryText(file.Summary(), "\t"))
+		if err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "total\t%v\n", summaryText(profile.Summary(), "\t"))
+	return err
+}
+
+// WriteGoProfile writes the statement counts in the Go cover profile format with count mode.
+// A statement is a block from its position to the end of its line, or to the next statement on the same line.
+func WriteGoProfile(w io.Writer, profile *Profile) error {
+	_, err := io.WriteString(w, "mode: count\n")
+	if err != nil {
+		return err
+	}
+
+	for _, file := range profile.Files() {
+		lines := strings.Split(file.Source, "\n")
+		statements := file.Coverage.Statements()
+		for i, statement := range statements {
+			line := ""
+			if statement.Pos.Line >= 1 && statement.Pos.Line <= len(lines) {
+				line = lines[statement.Pos.Line-1]
+			}
+			start := byteColumn(line, statement.Pos.Column)
+			end := len(strings.TrimRight(line, " \t\r")) + 1
+			if i+1 < len(statements) && statements[i+1].Pos.Line == statement.Pos.Line {
+				end = byteColumn(line, statements[i+1].Pos.Column)
+			}
+			if end < start {
+				end = start
+			}
+			_, err = fmt.Fprintf(w, "%v:%v.%v,%v.%v 1 %v\n", file.Name, statement.Pos.Line, start, statement.Pos.Line, end, statement.Count)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteHTML writes the source of the files with the lines colored by coverage:
+// green when the statements starting on the line ran, red when any did not run
+// and yellow when a branch on the line has an outcome that was not taken.
+// The title of a line has the counts.
+func WriteHTML(w io.Writer, profile *Profile) error {
+	var files []htmlFile
+	for _, file := range profile.Files() {
+		lines := strings.Split(strings.TrimSuffix(file.Source, "\n"), "\n")
+		htmlLines := make([]htmlLine, len(lines))
+		for i, line := range lines {
+			htmlLines[i] = htmlLine{Number: i + 1, Text: line}
+		}
+
+		titles := make([][]string, len(lines))
+		for _, statement := range file.Coverage.Statements() {
+			index := statement.Pos.Line - 1
+			if index < 0 || index >= len(lines) {
+				continue
+			}
+			switch {
+			case statement.Count == 0:
+				htmlLines[index].Class = "uncovered"
+			case htmlLines[index].Class == "":
+				htmlLines[index].Class = "covered"
+			}
+			titles[index] = append(titles[index], fmt.Sprintf("%v:%v run %v times", statement.Pos.Line, statement.Pos.Column, statement.Count))
+		}
+		for _, branch := range file.Coverage.Branches() {
+			index := branch.Pos.Line - 1
+			if index < 0 || index >= len(lines) {
+				continue
+			}
+			if len(notTakenOutcomes(branch)) > 0 && htmlLines[index].Class != "uncovered" {
+				htmlLines[index].Class = "partial"
+			}
+			outcomes := make([]string, len(branch.Outcomes))
+			for i, outcome := range branch.Outcomes {
+				outcomes[i] = fmt.Sprintf("%v %v", outcome, branch.Counts[i])
+			}
+			titles[index] = append(titles[index], fmt.Sprintf("%v:%v %v: %v", branch.Pos.Line, branch.Pos.Column, branch.Kind, strings.Join(outcomes, ", ")))
+		}
+		for i := range htmlLines {
+			htmlLines[i].Title = strings.Join(titles[i], "\n")
+		}
+
+		files = append(files, htmlFile{Name: file.Name, Summary: summaryText(file.Summary(), ", "), Lines: htmlLines})
+	}
+
+	return htmlTemplate.Execute(w, map[string]interface{}{
+		"Summary": summaryText(profile.Summary(), ", "),
+		"Files":   files,
+	})
+}
+
+// notTakenOutcomes returns the names of the branch outcomes with zero counts
+func notTakenOutcomes(branch vm.CoverageBranch) []string {
+	var notTaken []string
+	for i, count := range branch.Counts {
+		if count == 0 {
+			notTaken = append(notTaken, branch.Outcomes[i])
+		}
+	}
+	return notTaken
+}
+
+// summaryText returns the summary as text with the statements and branches separated by separator
+func summaryText(summary Summary, separator string) string {
+	return coverageText("statements", summary.CoveredStatements, summary.Statements) + separator +
+		coverageText("branches", summary.CoveredBranches, summary.Branches)
+}
+
+// coverageText returns the covered percentage and counts, like statements 80.0% (8/10)
+func coverageText(name string, covered int, total int) string {
+	if total == 0 {
+		return "[no " + name + "]"
+	}
+	return fmt.Sprintf("%v %.1f%% (%v/%v)", name, 100*float64(covered)/float64(total), covered, total)
+}
+
+// byteColumn returns the 1 based byte column of the 1 based rune column in line
+func byteColumn(line string, column int) int {
+	runes := []rune(line)
+	if column-1 > len(runes) {
+		return len(line) + 1
+	}
+	if column < 1 {
+		return 1
+	}
+	return len(string(runes[:column-1])) + 1
+}
+
+// positionAfter returns true if the first position is after the second position
+func positionAfter(line1 int, column1 int, line2 int, column2 int) bool {
+	if line1 != line2 {
+		return line1 > line2
+	}
+	return column1 > column2
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"sync"
 )
 
@@ -21,6 +22,8 @@ type (
 		parent         *Env
 		values         map[string]reflect.Value
 		types          map[string]reflect.Type
+		constants      map[string]struct{}
+		frozen         bool
 		externalLookup ExternalLookup
 	}
 )
@@ -34,6 +37,9 @@ var (
 	// reflect.Type must be valid or VM may crash.
 	// For nil type must use NilType.
 	PackageTypes = make(map[string]map[string]reflect.Type)
+	// FuncMapTypes are the map types with interface{} values, like template.FuncMap, where the VM converts
+	// script functions put in the map to func(...interface{}) interface{} so the Go code using the map can call them.
+	FuncMapTypes = make(map[reflect.Type]struct{})
 
 	// NilType is the reflect.type of nil
 	NilType = reflect.TypeOf(nil)
@@ -58,6 +64,10 @@ var (
 
 	// ErrSymbolContainsDot symbol contains .
 	ErrSymbolContainsDot = errors.New("symbol contains '.'")
+	// ErrConstant symbol is a constant
+	ErrConstant = errors.New("cannot modify constant")
+	// ErrFrozen scope is frozen
+	ErrFrozen = errors.New("cannot modify frozen scope")
 )
 
 // NewEnv creates new global scope.
@@ -88,6 +98,23 @@ func (e *Env) NewModule(symbol string) (*Env, error) {
 	return module, e.Define(symbol, module)
 }
 
+// Freeze makes current scope read-only.
+// Values and types in current scope can no longer be defined, set or deleted.
+// Parent scopes are not changed, so scripts should be run in a child scope of a frozen scope.
+func (e *Env) Freeze() {
+	e.rwMutex.Lock()
+	e.frozen = true
+	e.rwMutex.Unlock()
+}
+
+// IsFrozen returns true if current scope is frozen.
+func (e *Env) IsFrozen() bool {
+	e.rwMutex.RLock()
+	frozen := e.frozen
+	e.rwMutex.RUnlock()
+	return frozen
+}
+
 // SetExternalLookup sets an external lookup
 func (e *Env) SetExternalLookup(externalLookup ExternalLookup) {
 	e.externalLookup = externalLookup
@@ -116,6 +143,56 @@ func (e *Env) String() string {
 	return buffer.String()
 }
 
+// Parent returns the parent scope or nil if it is the global scope.
+func (e *Env) Parent() *Env {
+	return e.parent
+}
+
+// Symbols returns the sorted value symbols in current scope.
+func (e *Env) Symbols() []string {
+	e.rwMutex.RLock()
+	symbols := make([]string, 0, len(e.values))
+	for symbol := range e.values {
+		symbols = append(symbols, symbol)
+	}
+	e.rwMutex.RUnlock()
+	sort.Strings(symbols)
+	return symbols
+}
+
+// Types returns the sorted type symbols in current scope.
+func (e *Env) Types() []string {
+	e.rwMutex.RLock()
+	symbols := make([]string, 0, len(e.types))
+	for symbol := range e.types {
+		symbols = append(symbols, symbol)
+	}
+	e.rwMutex.RUnlock()
+	sort.Strings(symbols)
+	return symbols
+}
+
+// Walk calls walkFunc for each value in current scope and then in each parent scope.
+// scopeDepth is 0 for current scope and increases by one for each parent.
+// Symbols are walked in sorted order. Walk stops when walkFunc returns false.
+func (e *Env) Walk(walkFunc func(scopeDepth int, symbol string, value reflect.Value) bool) {
+	for scopeDepth := 0; e != nil; scopeDepth++ {
+		for _, symbol := range e.Symbols() {
+			e.rwMutex.RLock()
+			value, ok := e.values[symbol]
+			e.rwMutex.RUnlock()
+			if !ok {
+				// deleted while walking
+				continue
+			}
+			if !walkFunc(scopeDepth, symbol, value) {
+				return
+			}
+		}
+		e = e.parent
+	}
+}
+
 // GetEnvFromPath returns Env from path
 func (e *Env) GetEnvFromPath(path []string) (*Env, error) {
 	if len(path) < 1 {
@@ -161,11 +238,18 @@ func (e *Env) Copy() *Env {
 		rwMutex:        &sync.RWMutex{},
 		parent:         e.parent,
 		values:         make(map[string]reflect.Value, len(e.values)),
+		frozen:         e.frozen,
 		externalLookup: e.externalLookup,
 	}
 	for name, value := range e.values {
 		copy.values[name] = value
 	}
+	if e.constants != nil {
+		copy.constants = make(map[string]struct{}, len(e.constants))
+		for name := range e.constants {
+			copy.constants[name] = struct{}{}
+		}
+	}
 	if e.types != nil {
 		copy.types = make(map[string]reflect.Type, len(e.types))
 		for name, t := range e.types {
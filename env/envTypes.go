@@ -29,6 +29,10 @@ func (e *Env) DefineReflectType(symbol string, reflectType reflect.Type) error {
 	}
 
 	e.rwMutex.Lock()
+	if e.frozen {
+		e.rwMutex.Unlock()
+		return ErrFrozen
+	}
 	if e.types == nil {
 		e.types = make(map[string]reflect.Type)
 	}
@@ -22,9 +22,24 @@ func (e *Env) DefineValue(symbol string, value reflect.Value) error {
 		return ErrSymbolContainsDot
 	}
 	e.rwMutex.Lock()
-	e.values[symbol] = value
+	err := e.checkWritable(symbol)
+	if err == nil {
+		e.values[symbol] = value
+	}
 	e.rwMutex.Unlock()
 
+	return err
+}
+
+// checkWritable returns an error if symbol can not be changed in current scope.
+// rwMutex must be held by caller.
+func (e *Env) checkWritable(symbol string) error {
+	if e.frozen {
+		return ErrFrozen
+	}
+	if _, ok := e.constants[symbol]; ok {
+		return ErrConstant
+	}
 	return nil
 }
 
@@ -44,6 +59,51 @@ func (e *Env) DefineGlobalValue(symbol string, value reflect.Value) error {
 	return e.DefineValue(symbol, value)
 }
 
+// DefineConst defines interface value to constant symbol in current scope.
+// A constant symbol can not be set, redefined or deleted.
+func (e *Env) DefineConst(symbol string, value interface{}) error {
+	if value == nil {
+		return e.DefineConstValue(symbol, NilValue)
+	}
+	return e.DefineConstValue(symbol, reflect.ValueOf(value))
+}
+
+// DefineConstValue defines reflect value to constant symbol in current scope.
+// A constant symbol can not be set, redefined or deleted.
+func (e *Env) DefineConstValue(symbol string, value reflect.Value) error {
+	if strings.Contains(symbol, ".") {
+		return ErrSymbolContainsDot
+	}
+	e.rwMutex.Lock()
+	err := e.checkWritable(symbol)
+	if err == nil {
+		if e.constants == nil {
+			e.constants = make(map[string]struct{})
+		}
+		e.constants[symbol] = struct{}{}
+		e.values[symbol] = value
+	}
+	e.rwMutex.Unlock()
+
+	return err
+}
+
+// IsConst returns true if symbol is a constant in the scope where symbol is frist found.
+func (e *Env) IsConst(symbol string) bool {
+	e.rwMutex.RLock()
+	_, ok := e.values[symbol]
+	_, isConst := e.constants[symbol]
+	e.rwMutex.RUnlock()
+	if ok {
+		return isConst
+	}
+
+	if e.parent == nil {
+		return false
+	}
+	return e.parent.IsConst(symbol)
+}
+
 // set
 
 // Set interface value to the scope where symbol is frist found.
@@ -61,9 +121,12 @@ func (e *Env) SetValue(symbol string, value reflect.Value) error {
 	e.rwMutex.RUnlock()
 	if ok {
 		e.rwMutex.Lock()
-		e.values[symbol] = value
+		err := e.checkWritable(symbol)
+		if err == nil {
+			e.values[symbol] = value
+		}
 		e.rwMutex.Unlock()
-		return nil
+		return err
 	}
 
 	if e.parent == nil {
@@ -104,20 +167,52 @@ func (e *Env) GetValue(symbol string) (reflect.Value, error) {
 	return e.parent.GetValue(symbol)
 }
 
+// ScopeDepth returns the depth of the scope where symbol is first found,
+// 0 for current scope and increasing by one for each parent like in Walk, or -1 if symbol is not found.
+func (e *Env) ScopeDepth(symbol string) int {
+	for scopeDepth := 0; e != nil; scopeDepth++ {
+		e.rwMutex.RLock()
+		_, ok := e.values[symbol]
+		e.rwMutex.RUnlock()
+		if ok {
+			return scopeDepth
+		}
+		e = e.parent
+	}
+	return -1
+}
+
 // delete
 
 // Delete deletes symbol in current scope.
+// Constants and the symbols of frozen scopes are not deleted, use DeleteChecked to get the error.
 func (e *Env) Delete(symbol string) {
+	e.DeleteChecked(symbol)
+}
+
+// DeleteChecked deletes symbol in current scope.
+// It returns ErrConstant for a constant and ErrFrozen for a frozen scope.
+func (e *Env) DeleteChecked(symbol string) error {
 	e.rwMutex.Lock()
-	delete(e.values, symbol)
+	err := e.checkWritable(symbol)
+	if err == nil {
+		delete(e.values, symbol)
+	}
 	e.rwMutex.Unlock()
+	return err
 }
 
 // DeleteGlobal deletes the first matching symbol found in current or parent scope.
+// Constants and the symbols of frozen scopes are not deleted, use DeleteGlobalChecked to get the error.
 func (e *Env) DeleteGlobal(symbol string) {
+	e.DeleteGlobalChecked(symbol)
+}
+
+// DeleteGlobalChecked deletes the first matching symbol found in current or parent scope.
+// It returns ErrConstant for a constant and ErrFrozen for a frozen scope.
+func (e *Env) DeleteGlobalChecked(symbol string) error {
 	if e.parent == nil {
-		e.Delete(symbol)
-		return
+		return e.DeleteChecked(symbol)
 	}
 
 	e.rwMutex.RLock()
@@ -125,11 +220,10 @@ func (e *Env) DeleteGlobal(symbol string) {
 	e.rwMutex.RUnlock()
 
 	if ok {
-		e.Delete(symbol)
-		return
+		return e.DeleteChecked(symbol)
 	}
 
-	e.parent.DeleteGlobal(symbol)
+	return e.parent.DeleteGlobalChecked(symbol)
 }
 
 // Addr
@@ -425,6 +425,30 @@ func TestAddr(t *testing.T) {
 	}
 }
 
+func TestScopeDepth(t *testing.T) {
+	env := NewEnv()
+	env.Define("a", "a")
+	env.Define("b", "b")
+	child := env.NewEnv()
+	child.Define("b", "child b")
+	grandchild := child.NewEnv()
+
+	tests := []struct {
+		symbol     string
+		scopeDepth int
+	}{
+		{symbol: "a", scopeDepth: 2},
+		{symbol: "b", scopeDepth: 1},
+		{symbol: "c", scopeDepth: -1},
+	}
+	for _, test := range tests {
+		scopeDepth := grandchild.ScopeDepth(test.symbol)
+		if scopeDepth != test.scopeDepth {
+			t.Errorf("ScopeDepth %v - received: %v - expected: %v", test.symbol, scopeDepth, test.scopeDepth)
+		}
+	}
+}
+
 func TestDelete(t *testing.T) {
 	// empty
 	env := NewEnv()
@@ -511,6 +535,132 @@ func TestDeleteGlobal(t *testing.T) {
 	}
 }
 
+func TestDefineConst(t *testing.T) {
+	env := NewEnv()
+	err := env.DefineConst("a", "a")
+	if err != nil {
+		t.Fatal("DefineConst error:", err)
+	}
+	err = env.DefineConst("b.c", "b")
+	if err != ErrSymbolContainsDot {
+		t.Errorf("DefineConst error - received: %v - expected: %v", err, ErrSymbolContainsDot)
+	}
+	if !env.IsConst("a") {
+		t.Errorf("IsConst - received: %v - expected: %v", false, true)
+	}
+
+	envChild := env.NewEnv()
+	if !envChild.IsConst("a") {
+		t.Errorf("IsConst - received: %v - expected: %v", false, true)
+	}
+
+	err = envChild.Set("a", "b")
+	if err != ErrConstant {
+		t.Errorf("Set error - received: %v - expected: %v", err, ErrConstant)
+	}
+	err = env.Define("a", "b")
+	if err != ErrConstant {
+		t.Errorf("Define error - received: %v - expected: %v", err, ErrConstant)
+	}
+	err = env.DefineConst("a", "b")
+	if err != ErrConstant {
+		t.Errorf("DefineConst error - received: %v - expected: %v", err, ErrConstant)
+	}
+	err = env.DeleteChecked("a")
+	if err != ErrConstant {
+		t.Errorf("DeleteChecked error - received: %v - expected: %v", err, ErrConstant)
+	}
+	err = envChild.DeleteGlobalChecked("a")
+	if err != ErrConstant {
+		t.Errorf("DeleteGlobalChecked error - received: %v - expected: %v", err, ErrConstant)
+	}
+	// Delete and DeleteGlobal do not delete constants
+	env.Delete("a")
+	envChild.DeleteGlobal("a")
+
+	value, err := envChild.Get("a")
+	if err != nil {
+		t.Fatal("Get error:", err)
+	}
+	if value != "a" {
+		t.Errorf("Get value - received: %#v - expected: %#v", value, "a")
+	}
+
+	// shadow in child scope
+	err = envChild.Define("a", "b")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	if envChild.IsConst("a") {
+		t.Errorf("IsConst - received: %v - expected: %v", true, false)
+	}
+	err = envChild.Set("a", "c")
+	if err != nil {
+		t.Fatal("Set error:", err)
+	}
+
+	envCopy := env.Copy()
+	err = envCopy.Set("a", "b")
+	if err != ErrConstant {
+		t.Errorf("Set error - received: %v - expected: %v", err, ErrConstant)
+	}
+}
+
+func TestFreeze(t *testing.T) {
+	env := NewEnv()
+	env.Define("a", "a")
+	if env.IsFrozen() {
+		t.Errorf("IsFrozen - received: %v - expected: %v", true, false)
+	}
+	env.Freeze()
+	if !env.IsFrozen() {
+		t.Errorf("IsFrozen - received: %v - expected: %v", false, true)
+	}
+
+	err := env.Define("b", "b")
+	if err != ErrFrozen {
+		t.Errorf("Define error - received: %v - expected: %v", err, ErrFrozen)
+	}
+	err = env.DefineConst("b", "b")
+	if err != ErrFrozen {
+		t.Errorf("DefineConst error - received: %v - expected: %v", err, ErrFrozen)
+	}
+	err = env.DefineType("b", "b")
+	if err != ErrFrozen {
+		t.Errorf("DefineType error - received: %v - expected: %v", err, ErrFrozen)
+	}
+	err = env.Set("a", "b")
+	if err != ErrFrozen {
+		t.Errorf("Set error - received: %v - expected: %v", err, ErrFrozen)
+	}
+	err = env.DeleteChecked("a")
+	if err != ErrFrozen {
+		t.Errorf("DeleteChecked error - received: %v - expected: %v", err, ErrFrozen)
+	}
+
+	envChild := env.NewEnv()
+	err = envChild.Set("a", "b")
+	if err != ErrFrozen {
+		t.Errorf("Set error - received: %v - expected: %v", err, ErrFrozen)
+	}
+	err = envChild.Define("a", "b")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = envChild.DefineGlobal("c", "c")
+	if err != ErrFrozen {
+		t.Errorf("DefineGlobal error - received: %v - expected: %v", err, ErrFrozen)
+	}
+
+	value, err := env.Get("a")
+	if err != nil {
+		t.Fatal("Get error:", err)
+	}
+	if value != "a" {
+		t.Errorf("Get value - received: %#v - expected: %#v", value, "a")
+	}
+}
+
 func TestRaceCreateSameVariable(t *testing.T) {
 	// Test creating same variable in parallel
 
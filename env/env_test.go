@@ -41,6 +41,83 @@ string = string
 	}
 }
 
+func TestSymbolsAndTypes(t *testing.T) {
+	t.Parallel()
+
+	env := NewEnv()
+	if symbols := env.Symbols(); len(symbols) != 0 {
+		t.Errorf("Symbols - received: %v - expected: %v", symbols, []string{})
+	}
+	if types := env.Types(); len(types) != 0 {
+		t.Errorf("Types - received: %v - expected: %v", types, []string{})
+	}
+
+	env.Define("c", "c")
+	env.Define("a", "a")
+	env.Define("b", "b")
+	env.DefineType("y", "a")
+	env.DefineType("x", 1)
+
+	expected := []string{"a", "b", "c"}
+	if symbols := env.Symbols(); !reflect.DeepEqual(symbols, expected) {
+		t.Errorf("Symbols - received: %v - expected: %v", symbols, expected)
+	}
+	expected = []string{"x", "y"}
+	if types := env.Types(); !reflect.DeepEqual(types, expected) {
+		t.Errorf("Types - received: %v - expected: %v", types, expected)
+	}
+
+	child := env.NewEnv()
+	child.Define("d", "d")
+	expected = []string{"d"}
+	if symbols := child.Symbols(); !reflect.DeepEqual(symbols, expected) {
+		t.Errorf("Symbols - received: %v - expected: %v", symbols, expected)
+	}
+	if child.Parent() != env {
+		t.Errorf("Parent - received: %p - expected: %p", child.Parent(), env)
+	}
+	if env.Parent() != nil {
+		t.Errorf("Parent - received: %p - expected: nil", env.Parent())
+	}
+}
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	type walkItem struct {
+		scopeDepth int
+		symbol     string
+		value      interface{}
+	}
+
+	env := NewEnv()
+	env.Define("b", int64(2))
+	env.Define("a", int64(1))
+	child := env.NewEnv()
+	child.Define("c", "c")
+	child.Define("a", "a")
+
+	var items []walkItem
+	child.Walk(func(scopeDepth int, symbol string, value reflect.Value) bool {
+		items = append(items, walkItem{scopeDepth: scopeDepth, symbol: symbol, value: value.Interface()})
+		return true
+	})
+	expected := []walkItem{{0, "a", "a"}, {0, "c", "c"}, {1, "a", int64(1)}, {1, "b", int64(2)}}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("Walk - received: %v - expected: %v", items, expected)
+	}
+
+	items = nil
+	child.Walk(func(scopeDepth int, symbol string, value reflect.Value) bool {
+		items = append(items, walkItem{scopeDepth: scopeDepth, symbol: symbol, value: value.Interface()})
+		return scopeDepth < 1
+	})
+	expected = []walkItem{{0, "a", "a"}, {0, "c", "c"}, {1, "a", int64(1)}}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("Walk - received: %v - expected: %v", items, expected)
+	}
+}
+
 func TestGetEnvFromPath(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,175 @@
+// Package testlib is the table driven script test harness shared by the vm tests and the testkit package.
+// It does not import vm, so the tests of package vm can use it.
+package testlib
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+)
+
+// Test is a script test.
+type Test struct {
+	// Script is the script to parse and run.
+	Script string
+	// ParseError is the expected parse error, compared by error message.
+	// The script is still run after a parse error.
+	ParseError error
+	// ParseErrorFunc checks the parse error instead of ParseError.
+	ParseErrorFunc *func(*testing.T, error)
+	// EnvSetupFunc sets up the env of the test, after Options EnvSetupFunc.
+	EnvSetupFunc *func(*testing.T, *env.Env)
+	// Types are defined as types in the env before running the script.
+	Types map[string]interface{}
+	// Input is defined as values in the env before running the script.
+	Input map[string]interface{}
+	// RunError is the expected run error, compared by error message.
+	RunError error
+	// RunErrorFunc checks the run error instead of RunError.
+	RunErrorFunc *func(*testing.T, error)
+	// RunOutput is the expected value returned by the script.
+	RunOutput interface{}
+	// Output is the expected values of env symbols after running the script.
+	Output map[string]interface{}
+}
+
+// Options are the options for running tests.
+type Options struct {
+	// EnvSetupFunc sets up the env of every test.
+	EnvSetupFunc *func(*testing.T, *env.Env)
+	// Timeout is the context timeout for running the script of each test. Defaults to 60 seconds.
+	Timeout time.Duration
+}
+
+// RunFunc runs the parsed script in the env, like vm.RunContext.
+type RunFunc func(ctx context.Context, e *env.Env, stmt ast.Stmt) (interface{}, error)
+
+// Run runs the tests with run.
+func Run(t *testing.T, tests []Test, options *Options, run RunFunc) {
+	t.Helper()
+	for _, test := range tests {
+		RunTest(t, test, options, run)
+	}
+}
+
+// RunTest runs the test with run, reporting any differences as errors on t.
+func RunTest(t *testing.T, test Test, options *Options, run RunFunc) {
+	t.Helper()
+	timeout := 60 * time.Second
+
+	stmt, err := parser.ParseSrc(test.Script)
+	if test.ParseErrorFunc != nil {
+		(*test.ParseErrorFunc)(t, err)
+	} else if !ErrorEqual(err, test.ParseError) {
+		t.Errorf("ParseSrc error - received: %v - expected: %v - script: %v", err, test.ParseError, test.Script)
+		return
+	}
+	// Note: Still want to run the code even after a parse error to see what happens
+
+	envTest := env.NewEnv()
+	if options != nil {
+		if options.EnvSetupFunc != nil {
+			(*options.EnvSetupFunc)(t, envTest)
+		}
+		if options.Timeout != 0 {
+			timeout = options.Timeout
+		}
+	}
+	if test.EnvSetupFunc != nil {
+		(*test.EnvSetupFunc)(t, envTest)
+	}
+
+	for typeName, typeValue := range test.Types {
+		err = envTest.DefineType(typeName, typeValue)
+		if err != nil {
+			t.Errorf("DefineType error: %v - typeName: %v - script: %v", err, typeName, test.Script)
+			return
+		}
+	}
+
+	for inputName, inputValue := range test.Input {
+		err = envTest.Define(inputName, inputValue)
+		if err != nil {
+			t.Errorf("Define error: %v - inputName: %v - script: %v", err, inputName, test.Script)
+			return
+		}
+	}
+
+	var value interface{}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	value, err = run(ctx, envTest, stmt)
+	cancel()
+	if test.RunErrorFunc != nil {
+		(*test.RunErrorFunc)(t, err)
+	} else if !ErrorEqual(err, test.RunError) {
+		t.Errorf("Run error - received: %v - expected: %v - script: %v", err, test.RunError, test.Script)
+		return
+	}
+
+	if !ValueEqual(value, test.RunOutput) {
+		t.Errorf("Run output - received: %#v - expected: %#v - script: %v", value, test.RunOutput, test.Script)
+		t.Errorf("received type: %T - expected: %T", value, test.RunOutput)
+		return
+	}
+
+	for outputName, outputValue := range test.Output {
+		value, err = envTest.Get(outputName)
+		if err != nil {
+			t.Errorf("Get error: %v - outputName: %v - script: %v", err, outputName, test.Script)
+			return
+		}
+
+		if !ValueEqual(value, outputValue) {
+			t.Errorf("outputName %v - received: %#v - expected: %#v - script: %v", outputName, value, outputValue, test.Script)
+			t.Errorf("received type: %T - expected: %T", value, outputValue)
+			continue
+		}
+	}
+}
+
+// ErrorEqual returns true if both errors are nil or both have the same error message.
+func ErrorEqual(err1 error, err2 error) bool {
+	if err1 == nil || err2 == nil {
+		return err1 == err2
+	}
+	return err1.Error() == err2.Error()
+}
+
+// ValueEqual return true if v1 and v2 is same value. If passed function, does
+// extra checks otherwise just doing reflect.DeepEqual
+func ValueEqual(v1 interface{}, v2 interface{}) bool {
+	v1RV := reflect.ValueOf(v1)
+	switch v1RV.Kind() {
+	case reflect.Func:
+		// This is best effort to check if functions match, but it could be wrong
+		v2RV := reflect.ValueOf(v2)
+		if !v1RV.IsValid() || !v2RV.IsValid() {
+			if v1RV.IsValid() != !v2RV.IsValid() {
+				return false
+			}
+			return true
+		} else if v1RV.Kind() != v2RV.Kind() {
+			return false
+		} else if v1RV.Type() != v2RV.Type() {
+			return false
+		} else if v1RV.Pointer() != v2RV.Pointer() {
+			// From reflect: If v's Kind is Func, the returned pointer is an underlying code pointer, but not necessarily enough to identify a single function uniquely.
+			return false
+		}
+		return true
+	}
+	switch value1 := v1.(type) {
+	case error:
+		switch value2 := v2.(type) {
+		case error:
+			return value1.Error() == value2.Error()
+		}
+	}
+
+	return reflect.DeepEqual(v1, v2)
+}
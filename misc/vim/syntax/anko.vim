@@ -5,7 +5,7 @@ endif
 syn case match
 
 syn keyword     ankoDirective         module
-syn keyword     ankoDeclaration       var
+syn keyword     ankoDeclaration       var const
 
 hi def link     ankoDirective         Statement
 hi def link     ankoDeclaration       Type
@@ -0,0 +1,49 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"archive/tar"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["archive/tar"] = map[string]reflect.Value{
+		"ErrFieldTooLong":    reflect.ValueOf(tar.ErrFieldTooLong),
+		"ErrHeader":          reflect.ValueOf(tar.ErrHeader),
+		"ErrWriteAfterClose": reflect.ValueOf(tar.ErrWriteAfterClose),
+		"ErrWriteTooLong":    reflect.ValueOf(tar.ErrWriteTooLong),
+		"FileInfoHeader":     reflect.ValueOf(tar.FileInfoHeader),
+		"FormatGNU":          reflect.ValueOf(tar.FormatGNU),
+		"FormatPAX":          reflect.ValueOf(tar.FormatPAX),
+		"FormatUSTAR":        reflect.ValueOf(tar.FormatUSTAR),
+		"FormatUnknown":      reflect.ValueOf(tar.FormatUnknown),
+		"NewReader":          reflect.ValueOf(tar.NewReader),
+		"NewWriter":          reflect.ValueOf(tar.NewWriter),
+		"TypeBlock":          reflect.ValueOf(tar.TypeBlock),
+		"TypeChar":           reflect.ValueOf(tar.TypeChar),
+		"TypeCont":           reflect.ValueOf(tar.TypeCont),
+		"TypeDir":            reflect.ValueOf(tar.TypeDir),
+		"TypeFifo":           reflect.ValueOf(tar.TypeFifo),
+		"TypeGNULongLink":    reflect.ValueOf(tar.TypeGNULongLink),
+		"TypeGNULongName":    reflect.ValueOf(tar.TypeGNULongName),
+		"TypeGNUSparse":      reflect.ValueOf(tar.TypeGNUSparse),
+		"TypeLink":           reflect.ValueOf(tar.TypeLink),
+		"TypeReg":            reflect.ValueOf(tar.TypeReg),
+		"TypeRegA":           reflect.ValueOf(tar.TypeRegA),
+		"TypeSymlink":        reflect.ValueOf(tar.TypeSymlink),
+		"TypeXGlobalHeader":  reflect.ValueOf(tar.TypeXGlobalHeader),
+		"TypeXHeader":        reflect.ValueOf(tar.TypeXHeader),
+	}
+	env.PackageTypes["archive/tar"] = map[string]reflect.Type{
+		"Format": reflect.TypeOf(tar.Format(0)),
+		"Header": reflect.TypeOf(tar.Header{}),
+		"Reader": reflect.TypeOf(tar.Reader{}),
+		"Writer": reflect.TypeOf(tar.Writer{}),
+	}
+	archiveTarGo120()
+	archiveTarGo123()
+	archiveTarHelpers()
+}
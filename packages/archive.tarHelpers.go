@@ -0,0 +1,91 @@
+package packages
+
+import (
+	"archive/tar"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"time"
+
+	"github.com/mattn/anko/env"
+)
+
+type (
+	// tarEntries is the iterator of the entries of a tar archive that a for in loop can loop over
+	tarEntries struct {
+		reader *tar.Reader
+		entry  *tarEntry
+		err    error
+	}
+
+	// tarEntry is an entry of a tar archive, reading it reads the content of the entry
+	tarEntry struct {
+		Name   string
+		Header *tar.Header
+		reader *tar.Reader
+	}
+)
+
+// archiveTarHelpers adds the helpers of archive/tar, called by the generated init after the bindings are set
+func archiveTarHelpers() {
+	env.Packages["archive/tar"]["Entries"] = reflect.ValueOf(tarNewEntries)
+	env.Packages["archive/tar"]["WriteEntry"] = reflect.ValueOf(tarWriteEntry)
+}
+
+// tarNewEntries returns the iterator of the entries of the tar archive read from reader
+func tarNewEntries(reader io.Reader) *tarEntries {
+	return &tarEntries{reader: tar.NewReader(reader)}
+}
+
+// Next reads the header of the next entry, returns false at the end of the archive or on error
+func (entries *tarEntries) Next() bool {
+	if entries.err != nil {
+		return false
+	}
+	header, err := entries.reader.Next()
+	if err != nil {
+		if err != io.EOF {
+			entries.err = err
+		}
+		entries.entry = nil
+		return false
+	}
+	entries.entry = &tarEntry{Name: header.Name, Header: header, reader: entries.reader}
+	return true
+}
+
+// Value returns the current entry
+func (entries *tarEntries) Value() interface{} {
+	return entries.entry
+}
+
+// Err returns the error reading the archive
+func (entries *tarEntries) Err() error {
+	return entries.err
+}
+
+// Read reads the content of the entry
+func (entry *tarEntry) Read(p []byte) (int, error) {
+	return entry.reader.Read(p)
+}
+
+// ReadAll reads the rest of the content of the entry
+func (entry *tarEntry) ReadAll() ([]byte, error) {
+	return ioutil.ReadAll(entry.reader)
+}
+
+// tarWriteEntry writes a regular file entry with the name and data
+func tarWriteEntry(writer *tar.Writer, name string, data []byte) error {
+	err := writer.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0644,
+		Size:     int64(len(data)),
+		ModTime:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(data)
+	return err
+}
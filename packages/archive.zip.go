@@ -0,0 +1,37 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"archive/zip"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["archive/zip"] = map[string]reflect.Value{
+		"Deflate":              reflect.ValueOf(zip.Deflate),
+		"ErrAlgorithm":         reflect.ValueOf(zip.ErrAlgorithm),
+		"ErrChecksum":          reflect.ValueOf(zip.ErrChecksum),
+		"ErrFormat":            reflect.ValueOf(zip.ErrFormat),
+		"FileInfoHeader":       reflect.ValueOf(zip.FileInfoHeader),
+		"NewReader":            reflect.ValueOf(zip.NewReader),
+		"NewWriter":            reflect.ValueOf(zip.NewWriter),
+		"OpenReader":           reflect.ValueOf(zip.OpenReader),
+		"RegisterCompressor":   reflect.ValueOf(zip.RegisterCompressor),
+		"RegisterDecompressor": reflect.ValueOf(zip.RegisterDecompressor),
+		"Store":                reflect.ValueOf(zip.Store),
+	}
+	env.PackageTypes["archive/zip"] = map[string]reflect.Type{
+		"Compressor":   reflect.TypeOf((*zip.Compressor)(nil)).Elem(),
+		"Decompressor": reflect.TypeOf((*zip.Decompressor)(nil)).Elem(),
+		"File":         reflect.TypeOf(zip.File{}),
+		"FileHeader":   reflect.TypeOf(zip.FileHeader{}),
+		"ReadCloser":   reflect.TypeOf(zip.ReadCloser{}),
+		"Reader":       reflect.TypeOf(zip.Reader{}),
+		"Writer":       reflect.TypeOf(zip.Writer{}),
+	}
+	archiveZipGo120()
+	archiveZipHelpers()
+}
@@ -0,0 +1,88 @@
+package packages
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+type (
+	// zipEntries is the iterator of the entries of a zip archive that a for in loop can loop over
+	zipEntries struct {
+		files []*zip.File
+		index int
+	}
+
+	// zipEntry is an entry of a zip archive
+	zipEntry struct {
+		Name   string
+		Header *zip.FileHeader
+		file   *zip.File
+	}
+)
+
+// archiveZipHelpers adds the helpers of archive/zip, called by the generated init after the bindings are set
+func archiveZipHelpers() {
+	env.Packages["archive/zip"]["Entries"] = reflect.ValueOf(zipNewEntries)
+	env.Packages["archive/zip"]["WriteEntry"] = reflect.ValueOf(zipWriteEntry)
+}
+
+// zipNewEntries returns the iterator of the entries of the zip archive in data
+func zipNewEntries(data []byte) (*zipEntries, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	return &zipEntries{files: reader.File, index: -1}, nil
+}
+
+// Next moves to the next entry, returns false at the end of the archive
+func (entries *zipEntries) Next() bool {
+	if entries.index < len(entries.files) {
+		entries.index++
+	}
+	return entries.index < len(entries.files)
+}
+
+// Value returns the current entry
+func (entries *zipEntries) Value() interface{} {
+	if entries.index < 0 || entries.index >= len(entries.files) {
+		return nil
+	}
+	file := entries.files[entries.index]
+	return &zipEntry{Name: file.Name, Header: &file.FileHeader, file: file}
+}
+
+// Err returns nil, the archive is read by zipNewEntries
+func (entries *zipEntries) Err() error {
+	return nil
+}
+
+// Open returns a reader of the content of the entry
+func (entry *zipEntry) Open() (io.ReadCloser, error) {
+	return entry.file.Open()
+}
+
+// ReadAll reads the content of the entry
+func (entry *zipEntry) ReadAll() ([]byte, error) {
+	reader, err := entry.file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+// zipWriteEntry writes a deflated file entry with the name and data
+func zipWriteEntry(writer *zip.Writer, name string, data []byte) error {
+	entryWriter, err := writer.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = entryWriter.Write(data)
+	return err
+}
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -11,9 +13,12 @@ func init() {
 	env.Packages["bytes"] = map[string]reflect.Value{
 		"Compare":         reflect.ValueOf(bytes.Compare),
 		"Contains":        reflect.ValueOf(bytes.Contains),
+		"ContainsAny":     reflect.ValueOf(bytes.ContainsAny),
+		"ContainsRune":    reflect.ValueOf(bytes.ContainsRune),
 		"Count":           reflect.ValueOf(bytes.Count),
 		"Equal":           reflect.ValueOf(bytes.Equal),
 		"EqualFold":       reflect.ValueOf(bytes.EqualFold),
+		"ErrTooLarge":     reflect.ValueOf(bytes.ErrTooLarge),
 		"Fields":          reflect.ValueOf(bytes.Fields),
 		"FieldsFunc":      reflect.ValueOf(bytes.FieldsFunc),
 		"HasPrefix":       reflect.ValueOf(bytes.HasPrefix),
@@ -29,11 +34,13 @@ func init() {
 		"LastIndexByte":   reflect.ValueOf(bytes.LastIndexByte),
 		"LastIndexFunc":   reflect.ValueOf(bytes.LastIndexFunc),
 		"Map":             reflect.ValueOf(bytes.Map),
+		"MinRead":         reflect.ValueOf(bytes.MinRead),
 		"NewBuffer":       reflect.ValueOf(bytes.NewBuffer),
 		"NewBufferString": reflect.ValueOf(bytes.NewBufferString),
 		"NewReader":       reflect.ValueOf(bytes.NewReader),
 		"Repeat":          reflect.ValueOf(bytes.Repeat),
 		"Replace":         reflect.ValueOf(bytes.Replace),
+		"ReplaceAll":      reflect.ValueOf(bytes.ReplaceAll),
 		"Runes":           reflect.ValueOf(bytes.Runes),
 		"Split":           reflect.ValueOf(bytes.Split),
 		"SplitAfter":      reflect.ValueOf(bytes.SplitAfter),
@@ -46,6 +53,7 @@ func init() {
 		"ToTitleSpecial":  reflect.ValueOf(bytes.ToTitleSpecial),
 		"ToUpper":         reflect.ValueOf(bytes.ToUpper),
 		"ToUpperSpecial":  reflect.ValueOf(bytes.ToUpperSpecial),
+		"ToValidUTF8":     reflect.ValueOf(bytes.ToValidUTF8),
 		"Trim":            reflect.ValueOf(bytes.Trim),
 		"TrimFunc":        reflect.ValueOf(bytes.TrimFunc),
 		"TrimLeft":        reflect.ValueOf(bytes.TrimLeft),
@@ -60,5 +68,9 @@ func init() {
 		"Buffer": reflect.TypeOf(bytes.Buffer{}),
 		"Reader": reflect.TypeOf(bytes.Reader{}),
 	}
-	bytesGo17()
+	bytesGo118()
+	bytesGo120()
+	bytesGo121()
+	bytesGo124()
+	bytesGo127()
 }
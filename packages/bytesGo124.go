@@ -0,0 +1,21 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.24
+// +build go1.24
+
+package packages
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func bytesGo124() {
+	env.Packages["bytes"]["FieldsFuncSeq"] = reflect.ValueOf(bytes.FieldsFuncSeq)
+	env.Packages["bytes"]["FieldsSeq"] = reflect.ValueOf(bytes.FieldsSeq)
+	env.Packages["bytes"]["Lines"] = reflect.ValueOf(bytes.Lines)
+	env.Packages["bytes"]["SplitAfterSeq"] = reflect.ValueOf(bytes.SplitAfterSeq)
+	env.Packages["bytes"]["SplitSeq"] = reflect.ValueOf(bytes.SplitSeq)
+}
@@ -0,0 +1,30 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"compress/gzip"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["compress/gzip"] = map[string]reflect.Value{
+		"BestCompression":    reflect.ValueOf(gzip.BestCompression),
+		"BestSpeed":          reflect.ValueOf(gzip.BestSpeed),
+		"DefaultCompression": reflect.ValueOf(gzip.DefaultCompression),
+		"ErrChecksum":        reflect.ValueOf(gzip.ErrChecksum),
+		"ErrHeader":          reflect.ValueOf(gzip.ErrHeader),
+		"HuffmanOnly":        reflect.ValueOf(gzip.HuffmanOnly),
+		"NewReader":          reflect.ValueOf(gzip.NewReader),
+		"NewWriter":          reflect.ValueOf(gzip.NewWriter),
+		"NewWriterLevel":     reflect.ValueOf(gzip.NewWriterLevel),
+		"NoCompression":      reflect.ValueOf(gzip.NoCompression),
+	}
+	env.PackageTypes["compress/gzip"] = map[string]reflect.Type{
+		"Header": reflect.TypeOf(gzip.Header{}),
+		"Reader": reflect.TypeOf(gzip.Reader{}),
+		"Writer": reflect.TypeOf(gzip.Writer{}),
+	}
+}
@@ -0,0 +1,32 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"compress/zlib"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["compress/zlib"] = map[string]reflect.Value{
+		"BestCompression":    reflect.ValueOf(zlib.BestCompression),
+		"BestSpeed":          reflect.ValueOf(zlib.BestSpeed),
+		"DefaultCompression": reflect.ValueOf(zlib.DefaultCompression),
+		"ErrChecksum":        reflect.ValueOf(zlib.ErrChecksum),
+		"ErrDictionary":      reflect.ValueOf(zlib.ErrDictionary),
+		"ErrHeader":          reflect.ValueOf(zlib.ErrHeader),
+		"HuffmanOnly":        reflect.ValueOf(zlib.HuffmanOnly),
+		"NewReader":          reflect.ValueOf(zlib.NewReader),
+		"NewReaderDict":      reflect.ValueOf(zlib.NewReaderDict),
+		"NewWriter":          reflect.ValueOf(zlib.NewWriter),
+		"NewWriterLevel":     reflect.ValueOf(zlib.NewWriterLevel),
+		"NewWriterLevelDict": reflect.ValueOf(zlib.NewWriterLevelDict),
+		"NoCompression":      reflect.ValueOf(zlib.NoCompression),
+	}
+	env.PackageTypes["compress/zlib"] = map[string]reflect.Type{
+		"Resetter": reflect.TypeOf((*zlib.Resetter)(nil)).Elem(),
+		"Writer":   reflect.TypeOf(zlib.Writer{}),
+	}
+}
@@ -0,0 +1,29 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["context"] = map[string]reflect.Value{
+		"Background":       reflect.ValueOf(context.Background),
+		"Canceled":         reflect.ValueOf(context.Canceled),
+		"DeadlineExceeded": reflect.ValueOf(context.DeadlineExceeded),
+		"TODO":             reflect.ValueOf(context.TODO),
+		"WithCancel":       reflect.ValueOf(context.WithCancel),
+		"WithDeadline":     reflect.ValueOf(context.WithDeadline),
+		"WithTimeout":      reflect.ValueOf(context.WithTimeout),
+		"WithValue":        reflect.ValueOf(context.WithValue),
+	}
+	env.PackageTypes["context"] = map[string]reflect.Type{
+		"CancelFunc": reflect.TypeOf((*context.CancelFunc)(nil)).Elem(),
+		"Context":    reflect.TypeOf((*context.Context)(nil)).Elem(),
+	}
+	contextGo120()
+	contextGo121()
+}
@@ -0,0 +1,20 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.21
+// +build go1.21
+
+package packages
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func contextGo121() {
+	env.Packages["context"]["AfterFunc"] = reflect.ValueOf(context.AfterFunc)
+	env.Packages["context"]["WithDeadlineCause"] = reflect.ValueOf(context.WithDeadlineCause)
+	env.Packages["context"]["WithTimeoutCause"] = reflect.ValueOf(context.WithTimeoutCause)
+	env.Packages["context"]["WithoutCancel"] = reflect.ValueOf(context.WithoutCancel)
+}
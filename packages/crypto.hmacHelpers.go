@@ -0,0 +1,22 @@
+package packages
+
+import (
+	"crypto/hmac"
+	"encoding/hex"
+	"hash"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+// cryptoHmacHelpers adds the helpers of crypto/hmac, called by the generated init after the bindings are set
+func cryptoHmacHelpers() {
+	env.Packages["crypto/hmac"]["SumHex"] = reflect.ValueOf(hmacSumHex)
+}
+
+// hmacSumHex returns the HMAC of the data with the key as a hex string, using the hash of newHash like sha256.New
+func hmacSumHex(newHash func() hash.Hash, key []byte, data []byte) string {
+	mac := hmac.New(newHash, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,20 @@
+package packages
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+// cryptoMd5Helpers adds the helpers of crypto/md5, called by the generated init after the bindings are set
+func cryptoMd5Helpers() {
+	env.Packages["crypto/md5"]["SumHex"] = reflect.ValueOf(md5SumHex)
+}
+
+// md5SumHex returns the MD5 checksum of the data as a hex string
+func md5SumHex(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
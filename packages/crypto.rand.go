@@ -0,0 +1,20 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"crypto/rand"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["crypto/rand"] = map[string]reflect.Value{
+		"Int":    reflect.ValueOf(rand.Int),
+		"Prime":  reflect.ValueOf(rand.Prime),
+		"Read":   reflect.ValueOf(rand.Read),
+		"Reader": reflect.ValueOf(rand.Reader),
+	}
+	cryptoRandGo124()
+}
@@ -0,0 +1,20 @@
+package packages
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+// cryptoSha1Helpers adds the helpers of crypto/sha1, called by the generated init after the bindings are set
+func cryptoSha1Helpers() {
+	env.Packages["crypto/sha1"]["SumHex"] = reflect.ValueOf(sha1SumHex)
+}
+
+// sha1SumHex returns the SHA-1 checksum of the data as a hex string
+func sha1SumHex(data []byte) string {
+	sum := sha1.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
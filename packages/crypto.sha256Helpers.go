@@ -0,0 +1,27 @@
+package packages
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+// cryptoSha256Helpers adds the helpers of crypto/sha256, called by the generated init after the bindings are set
+func cryptoSha256Helpers() {
+	env.Packages["crypto/sha256"]["Sum224Hex"] = reflect.ValueOf(sha256Sum224Hex)
+	env.Packages["crypto/sha256"]["Sum256Hex"] = reflect.ValueOf(sha256Sum256Hex)
+}
+
+// sha256Sum224Hex returns the SHA-224 checksum of the data as a hex string
+func sha256Sum224Hex(data []byte) string {
+	sum := sha256.Sum224(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// sha256Sum256Hex returns the SHA-256 checksum of the data as a hex string
+func sha256Sum256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
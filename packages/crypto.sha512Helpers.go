@@ -0,0 +1,27 @@
+package packages
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+// cryptoSha512Helpers adds the helpers of crypto/sha512, called by the generated init after the bindings are set
+func cryptoSha512Helpers() {
+	env.Packages["crypto/sha512"]["Sum384Hex"] = reflect.ValueOf(sha512Sum384Hex)
+	env.Packages["crypto/sha512"]["Sum512Hex"] = reflect.ValueOf(sha512Sum512Hex)
+}
+
+// sha512Sum384Hex returns the SHA-384 checksum of the data as a hex string
+func sha512Sum384Hex(data []byte) string {
+	sum := sha512.Sum384(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// sha512Sum512Hex returns the SHA-512 checksum of the data as a hex string
+func sha512Sum512Hex(data []byte) string {
+	sum := sha512.Sum512(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,23 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"crypto/subtle"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["crypto/subtle"] = map[string]reflect.Value{
+		"ConstantTimeByteEq":   reflect.ValueOf(subtle.ConstantTimeByteEq),
+		"ConstantTimeCompare":  reflect.ValueOf(subtle.ConstantTimeCompare),
+		"ConstantTimeCopy":     reflect.ValueOf(subtle.ConstantTimeCopy),
+		"ConstantTimeEq":       reflect.ValueOf(subtle.ConstantTimeEq),
+		"ConstantTimeLessOrEq": reflect.ValueOf(subtle.ConstantTimeLessOrEq),
+		"ConstantTimeSelect":   reflect.ValueOf(subtle.ConstantTimeSelect),
+	}
+	cryptoSubtleGo120()
+	cryptoSubtleGo124()
+}
@@ -0,0 +1,56 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"database/sql"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["database/sql"] = map[string]reflect.Value{
+		"Drivers":              reflect.ValueOf(sql.Drivers),
+		"ErrConnDone":          reflect.ValueOf(sql.ErrConnDone),
+		"ErrNoRows":            reflect.ValueOf(sql.ErrNoRows),
+		"ErrTxDone":            reflect.ValueOf(sql.ErrTxDone),
+		"LevelDefault":         reflect.ValueOf(sql.LevelDefault),
+		"LevelLinearizable":    reflect.ValueOf(sql.LevelLinearizable),
+		"LevelReadCommitted":   reflect.ValueOf(sql.LevelReadCommitted),
+		"LevelReadUncommitted": reflect.ValueOf(sql.LevelReadUncommitted),
+		"LevelRepeatableRead":  reflect.ValueOf(sql.LevelRepeatableRead),
+		"LevelSerializable":    reflect.ValueOf(sql.LevelSerializable),
+		"LevelSnapshot":        reflect.ValueOf(sql.LevelSnapshot),
+		"LevelWriteCommitted":  reflect.ValueOf(sql.LevelWriteCommitted),
+		"Named":                reflect.ValueOf(sql.Named),
+		"Open":                 reflect.ValueOf(sql.Open),
+		"OpenDB":               reflect.ValueOf(sql.OpenDB),
+	}
+	env.PackageTypes["database/sql"] = map[string]reflect.Type{
+		"ColumnType":     reflect.TypeOf(sql.ColumnType{}),
+		"Conn":           reflect.TypeOf(sql.Conn{}),
+		"DB":             reflect.TypeOf(sql.DB{}),
+		"DBStats":        reflect.TypeOf(sql.DBStats{}),
+		"IsolationLevel": reflect.TypeOf(sql.IsolationLevel(0)),
+		"NamedArg":       reflect.TypeOf(sql.NamedArg{}),
+		"NullBool":       reflect.TypeOf(sql.NullBool{}),
+		"NullFloat64":    reflect.TypeOf(sql.NullFloat64{}),
+		"NullInt32":      reflect.TypeOf(sql.NullInt32{}),
+		"NullInt64":      reflect.TypeOf(sql.NullInt64{}),
+		"NullString":     reflect.TypeOf(sql.NullString{}),
+		"NullTime":       reflect.TypeOf(sql.NullTime{}),
+		"Out":            reflect.TypeOf(sql.Out{}),
+		"RawBytes":       reflect.TypeOf(sql.RawBytes{}),
+		"Result":         reflect.TypeOf((*sql.Result)(nil)).Elem(),
+		"Row":            reflect.TypeOf(sql.Row{}),
+		"Rows":           reflect.TypeOf(sql.Rows{}),
+		"Scanner":        reflect.TypeOf((*sql.Scanner)(nil)).Elem(),
+		"Stmt":           reflect.TypeOf(sql.Stmt{}),
+		"Tx":             reflect.TypeOf(sql.Tx{}),
+		"TxOptions":      reflect.TypeOf(sql.TxOptions{}),
+	}
+	databaseSqlGo117()
+	databaseSqlGo127()
+	databaseSqlHelpers()
+}
@@ -0,0 +1,90 @@
+package packages
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/mattn/anko/env"
+)
+
+var (
+	sqlDriversMutex sync.RWMutex
+	sqlDrivers      = make(map[string]driver.Driver)
+)
+
+// sqlConnector is the driver.Connector of a driver that does not implement driver.DriverContext
+type sqlConnector struct {
+	name   string
+	driver driver.Driver
+}
+
+// databaseSqlHelpers adds the helpers of database/sql, called by the generated init after the bindings are set
+func databaseSqlHelpers() {
+	env.Packages["database/sql"]["Drivers"] = reflect.ValueOf(SQLDrivers)
+	env.Packages["database/sql"]["Open"] = reflect.ValueOf(sqlOpen)
+}
+
+// RegisterSQLDriver registers the driver with the name for sql.Open of scripts.
+// Scripts can only open the drivers registered with RegisterSQLDriver, not the drivers registered with database/sql.
+// If the name is already registered, the driver replaces the registered driver.
+func RegisterSQLDriver(name string, sqlDriver driver.Driver) {
+	if sqlDriver == nil {
+		panic("RegisterSQLDriver driver is nil")
+	}
+	sqlDriversMutex.Lock()
+	sqlDrivers[name] = sqlDriver
+	sqlDriversMutex.Unlock()
+}
+
+// UnregisterSQLDriver removes the driver with the name from the drivers sql.Open of scripts can open
+func UnregisterSQLDriver(name string) {
+	sqlDriversMutex.Lock()
+	delete(sqlDrivers, name)
+	sqlDriversMutex.Unlock()
+}
+
+// SQLDrivers returns the sorted names of the registered drivers that scripts can open
+func SQLDrivers() []string {
+	sqlDriversMutex.RLock()
+	names := make([]string, 0, len(sqlDrivers))
+	for name := range sqlDrivers {
+		names = append(names, name)
+	}
+	sqlDriversMutex.RUnlock()
+	sort.Strings(names)
+	return names
+}
+
+// sqlOpen opens a database with a driver registered with RegisterSQLDriver
+func sqlOpen(driverName string, dataSourceName string) (*sql.DB, error) {
+	sqlDriversMutex.RLock()
+	sqlDriver, ok := sqlDrivers[driverName]
+	sqlDriversMutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("sql: driver %q is not registered", driverName)
+	}
+
+	if driverContext, ok := sqlDriver.(driver.DriverContext); ok {
+		connector, err := driverContext.OpenConnector(dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return sql.OpenDB(connector), nil
+	}
+	return sql.OpenDB(sqlConnector{name: dataSourceName, driver: sqlDriver}), nil
+}
+
+// Connect opens a connection with the data source name
+func (connector sqlConnector) Connect(context.Context) (driver.Conn, error) {
+	return connector.driver.Open(connector.name)
+}
+
+// Driver returns the driver of the connector
+func (connector sqlConnector) Driver() driver.Driver {
+	return connector.driver
+}
@@ -0,0 +1,28 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"encoding/base64"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["encoding/base64"] = map[string]reflect.Value{
+		"NewDecoder":     reflect.ValueOf(base64.NewDecoder),
+		"NewEncoder":     reflect.ValueOf(base64.NewEncoder),
+		"NewEncoding":    reflect.ValueOf(base64.NewEncoding),
+		"NoPadding":      reflect.ValueOf(base64.NoPadding),
+		"RawStdEncoding": reflect.ValueOf(base64.RawStdEncoding),
+		"RawURLEncoding": reflect.ValueOf(base64.RawURLEncoding),
+		"StdEncoding":    reflect.ValueOf(base64.StdEncoding),
+		"StdPadding":     reflect.ValueOf(base64.StdPadding),
+		"URLEncoding":    reflect.ValueOf(base64.URLEncoding),
+	}
+	env.PackageTypes["encoding/base64"] = map[string]reflect.Type{
+		"CorruptInputError": reflect.TypeOf(base64.CorruptInputError(0)),
+		"Encoding":          reflect.TypeOf(base64.Encoding{}),
+	}
+}
@@ -0,0 +1,27 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"encoding/csv"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["encoding/csv"] = map[string]reflect.Value{
+		"ErrBareQuote":     reflect.ValueOf(csv.ErrBareQuote),
+		"ErrFieldCount":    reflect.ValueOf(csv.ErrFieldCount),
+		"ErrQuote":         reflect.ValueOf(csv.ErrQuote),
+		"ErrTrailingComma": reflect.ValueOf(csv.ErrTrailingComma),
+		"NewReader":        reflect.ValueOf(csv.NewReader),
+		"NewWriter":        reflect.ValueOf(csv.NewWriter),
+	}
+	env.PackageTypes["encoding/csv"] = map[string]reflect.Type{
+		"ParseError": reflect.TypeOf(csv.ParseError{}),
+		"Reader":     reflect.TypeOf(csv.Reader{}),
+		"Writer":     reflect.TypeOf(csv.Writer{}),
+	}
+	encodingCsvHelpers()
+}
@@ -0,0 +1,52 @@
+package packages
+
+import (
+	"encoding/csv"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mattn/anko/env"
+)
+
+// encodingCsvHelpers adds the helpers of encoding/csv, called by the generated init after the bindings are set
+func encodingCsvHelpers() {
+	env.Packages["encoding/csv"]["ReadAllString"] = reflect.ValueOf(csvReadAllString)
+	env.Packages["encoding/csv"]["WriteAllString"] = reflect.ValueOf(csvWriteAllString)
+}
+
+// csvReadAllString returns all rows of the CSV text
+func csvReadAllString(text string) ([][]string, error) {
+	return csv.NewReader(strings.NewReader(text)).ReadAll()
+}
+
+// csvWriteAllString returns the rows as CSV text. Rows is a slice of slices, the cells are formatted with fmt.Sprint.
+func csvWriteAllString(rows interface{}) (string, error) {
+	rowsValue := reflect.ValueOf(rows)
+	if rowsValue.Kind() != reflect.Slice && rowsValue.Kind() != reflect.Array {
+		return "", fmt.Errorf("rows is type %T and not a slice of rows", rows)
+	}
+
+	records := make([][]string, rowsValue.Len())
+	for i := range records {
+		row := rowsValue.Index(i)
+		if row.Kind() == reflect.Interface {
+			row = row.Elem()
+		}
+		if row.Kind() != reflect.Slice && row.Kind() != reflect.Array {
+			return "", fmt.Errorf("row %v is type %v and not a slice of cells", i, row.Kind())
+		}
+		records[i] = make([]string, row.Len())
+		for j := range records[i] {
+			cell := row.Index(j)
+			if cell.Kind() == reflect.Interface && cell.IsNil() {
+				continue
+			}
+			records[i][j] = fmt.Sprint(cell.Interface())
+		}
+	}
+
+	var builder strings.Builder
+	err := csv.NewWriter(&builder).WriteAll(records)
+	return builder.String(), err
+}
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,7 +11,33 @@ import (
 
 func init() {
 	env.Packages["encoding/json"] = map[string]reflect.Value{
-		"Marshal":   reflect.ValueOf(json.Marshal),
-		"Unmarshal": reflect.ValueOf(json.Unmarshal),
+		"Compact":       reflect.ValueOf(json.Compact),
+		"HTMLEscape":    reflect.ValueOf(json.HTMLEscape),
+		"Indent":        reflect.ValueOf(json.Indent),
+		"Marshal":       reflect.ValueOf(json.Marshal),
+		"MarshalIndent": reflect.ValueOf(json.MarshalIndent),
+		"NewDecoder":    reflect.ValueOf(json.NewDecoder),
+		"NewEncoder":    reflect.ValueOf(json.NewEncoder),
+		"Unmarshal":     reflect.ValueOf(json.Unmarshal),
+		"Valid":         reflect.ValueOf(json.Valid),
+	}
+	env.PackageTypes["encoding/json"] = map[string]reflect.Type{
+		"Decoder":               reflect.TypeOf(json.Decoder{}),
+		"Delim":                 reflect.TypeOf(json.Delim(0)),
+		"Encoder":               reflect.TypeOf(json.Encoder{}),
+		"InvalidUTF8Error":      reflect.TypeOf(json.InvalidUTF8Error{}),
+		"InvalidUnmarshalError": reflect.TypeOf(json.InvalidUnmarshalError{}),
+		"Marshaler":             reflect.TypeOf((*json.Marshaler)(nil)).Elem(),
+		"MarshalerError":        reflect.TypeOf(json.MarshalerError{}),
+		"Number":                reflect.TypeOf(json.Number("")),
+		"RawMessage":            reflect.TypeOf(json.RawMessage{}),
+		"SyntaxError":           reflect.TypeOf(json.SyntaxError{}),
+		"Token":                 reflect.TypeOf((*json.Token)(nil)).Elem(),
+		"UnmarshalFieldError":   reflect.TypeOf(json.UnmarshalFieldError{}),
+		"UnmarshalTypeError":    reflect.TypeOf(json.UnmarshalTypeError{}),
+		"Unmarshaler":           reflect.TypeOf((*json.Unmarshaler)(nil)).Elem(),
+		"UnsupportedTypeError":  reflect.TypeOf(json.UnsupportedTypeError{}),
+		"UnsupportedValueError": reflect.TypeOf(json.UnsupportedValueError{}),
 	}
+	encodingJsonGo127()
 }
@@ -0,0 +1,30 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.27
+// +build go1.27
+
+package packages
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func encodingJsonGo127() {
+	env.Packages["encoding/json"]["CallMethodsWithLegacySemantics"] = reflect.ValueOf(json.CallMethodsWithLegacySemantics)
+	env.Packages["encoding/json"]["DefaultOptionsV1"] = reflect.ValueOf(json.DefaultOptionsV1)
+	env.Packages["encoding/json"]["FormatByteArrayAsArray"] = reflect.ValueOf(json.FormatByteArrayAsArray)
+	env.Packages["encoding/json"]["FormatBytesWithLegacySemantics"] = reflect.ValueOf(json.FormatBytesWithLegacySemantics)
+	env.Packages["encoding/json"]["FormatDurationAsNano"] = reflect.ValueOf(json.FormatDurationAsNano)
+	env.Packages["encoding/json"]["MatchCaseSensitiveDelimiter"] = reflect.ValueOf(json.MatchCaseSensitiveDelimiter)
+	env.Packages["encoding/json"]["MergeWithLegacySemantics"] = reflect.ValueOf(json.MergeWithLegacySemantics)
+	env.Packages["encoding/json"]["OmitEmptyWithLegacySemantics"] = reflect.ValueOf(json.OmitEmptyWithLegacySemantics)
+	env.Packages["encoding/json"]["ParseBytesWithLooseRFC4648"] = reflect.ValueOf(json.ParseBytesWithLooseRFC4648)
+	env.Packages["encoding/json"]["ParseTimeWithLooseRFC3339"] = reflect.ValueOf(json.ParseTimeWithLooseRFC3339)
+	env.Packages["encoding/json"]["ReportErrorsWithLegacySemantics"] = reflect.ValueOf(json.ReportErrorsWithLegacySemantics)
+	env.Packages["encoding/json"]["StringifyWithLegacySemantics"] = reflect.ValueOf(json.StringifyWithLegacySemantics)
+	env.Packages["encoding/json"]["UnmarshalArrayFromAnyLength"] = reflect.ValueOf(json.UnmarshalArrayFromAnyLength)
+	env.PackageTypes["encoding/json"]["Options"] = reflect.TypeOf((*json.Options)(nil)).Elem()
+}
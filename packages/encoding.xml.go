@@ -0,0 +1,50 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"encoding/xml"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["encoding/xml"] = map[string]reflect.Value{
+		"CopyToken":       reflect.ValueOf(xml.CopyToken),
+		"Escape":          reflect.ValueOf(xml.Escape),
+		"EscapeText":      reflect.ValueOf(xml.EscapeText),
+		"HTMLAutoClose":   reflect.ValueOf(xml.HTMLAutoClose),
+		"HTMLEntity":      reflect.ValueOf(xml.HTMLEntity),
+		"Header":          reflect.ValueOf(xml.Header),
+		"Marshal":         reflect.ValueOf(xml.Marshal),
+		"MarshalIndent":   reflect.ValueOf(xml.MarshalIndent),
+		"NewDecoder":      reflect.ValueOf(xml.NewDecoder),
+		"NewEncoder":      reflect.ValueOf(xml.NewEncoder),
+		"NewTokenDecoder": reflect.ValueOf(xml.NewTokenDecoder),
+		"Unmarshal":       reflect.ValueOf(xml.Unmarshal),
+	}
+	env.PackageTypes["encoding/xml"] = map[string]reflect.Type{
+		"Attr":                 reflect.TypeOf(xml.Attr{}),
+		"CharData":             reflect.TypeOf(xml.CharData{}),
+		"Comment":              reflect.TypeOf(xml.Comment{}),
+		"Decoder":              reflect.TypeOf(xml.Decoder{}),
+		"Directive":            reflect.TypeOf(xml.Directive{}),
+		"Encoder":              reflect.TypeOf(xml.Encoder{}),
+		"EndElement":           reflect.TypeOf(xml.EndElement{}),
+		"Marshaler":            reflect.TypeOf((*xml.Marshaler)(nil)).Elem(),
+		"MarshalerAttr":        reflect.TypeOf((*xml.MarshalerAttr)(nil)).Elem(),
+		"Name":                 reflect.TypeOf(xml.Name{}),
+		"ProcInst":             reflect.TypeOf(xml.ProcInst{}),
+		"StartElement":         reflect.TypeOf(xml.StartElement{}),
+		"SyntaxError":          reflect.TypeOf(xml.SyntaxError{}),
+		"TagPathError":         reflect.TypeOf(xml.TagPathError{}),
+		"Token":                reflect.TypeOf((*xml.Token)(nil)).Elem(),
+		"TokenReader":          reflect.TypeOf((*xml.TokenReader)(nil)).Elem(),
+		"UnmarshalError":       reflect.TypeOf(xml.UnmarshalError("")),
+		"Unmarshaler":          reflect.TypeOf((*xml.Unmarshaler)(nil)).Elem(),
+		"UnmarshalerAttr":      reflect.TypeOf((*xml.UnmarshalerAttr)(nil)).Elem(),
+		"UnsupportedTypeError": reflect.TypeOf(xml.UnsupportedTypeError{}),
+	}
+	encodingXmlHelpers()
+}
@@ -0,0 +1,205 @@
+package packages
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/mattn/anko/env"
+)
+
+// encodingXmlHelpers adds the helpers of encoding/xml, called by the generated init after the bindings are set
+func encodingXmlHelpers() {
+	env.Packages["encoding/xml"]["MarshalMap"] = reflect.ValueOf(xmlMarshalMap)
+	env.Packages["encoding/xml"]["UnmarshalMap"] = reflect.ValueOf(xmlUnmarshalMap)
+}
+
+// xmlUnmarshalMap returns the XML document as a map of the root element name to its value.
+// An element without attributes and child elements is its text. Else it is a map with the
+// attributes as @name keys, the child elements as name keys, with a slice for repeated child elements,
+// and the text as the #text key. Text is trimmed of surrounding white space.
+func xmlUnmarshalMap(data []byte) (map[string]interface{}, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	document := make(map[string]interface{})
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return document, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			value, err := xmlElementValue(decoder, start)
+			if err != nil {
+				return nil, err
+			}
+			xmlAddValue(document, start.Name.Local, value)
+		}
+	}
+}
+
+// xmlElementValue returns the value of the element from start to its end element
+func xmlElementValue(decoder *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	element := make(map[string]interface{})
+	for _, attr := range start.Attr {
+		element["@"+attr.Name.Local] = attr.Value
+	}
+
+	var text strings.Builder
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			value, err := xmlElementValue(decoder, token)
+			if err != nil {
+				return nil, err
+			}
+			xmlAddValue(element, token.Name.Local, value)
+		case xml.CharData:
+			text.Write(token)
+		case xml.EndElement:
+			trimmed := strings.TrimSpace(text.String())
+			if len(element) == 0 {
+				return trimmed, nil
+			}
+			if trimmed != "" {
+				element["#text"] = trimmed
+			}
+			return element, nil
+		}
+	}
+}
+
+// xmlAddValue adds the value of a child element, making a slice for repeated elements
+func xmlAddValue(element map[string]interface{}, name string, value interface{}) {
+	existing, ok := element[name]
+	if !ok {
+		element[name] = value
+		return
+	}
+	if values, ok := existing.([]interface{}); ok {
+		element[name] = append(values, value)
+		return
+	}
+	element[name] = []interface{}{existing, value}
+}
+
+// xmlMarshalMap returns the XML of a map in the format of xmlUnmarshalMap.
+// Each key is an element, maps are elements with @name attributes, a #text key and child elements,
+// slices are repeated elements and other values are the text of the element.
+// Keys are written in sorted order.
+func xmlMarshalMap(value interface{}) ([]byte, error) {
+	mapValue := reflect.ValueOf(value)
+	if mapValue.Kind() != reflect.Map {
+		return nil, fmt.Errorf("value is type %T and not a map", value)
+	}
+
+	var buffer bytes.Buffer
+	encoder := xml.NewEncoder(&buffer)
+	err := xmlEncodeChildren(encoder, mapValue)
+	if err != nil {
+		return nil, err
+	}
+	err = encoder.Flush()
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// xmlEncodeChildren encodes the keys of the map that are not attributes or text as elements
+func xmlEncodeChildren(encoder *xml.Encoder, mapValue reflect.Value) error {
+	for _, key := range xmlSortedKeys(mapValue) {
+		name := fmt.Sprint(key.Interface())
+		if strings.HasPrefix(name, "@") || name == "#text" {
+			continue
+		}
+		err := xmlEncodeElement(encoder, name, mapValue.MapIndex(key))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// xmlEncodeElement encodes the value as elements with the name
+func xmlEncodeElement(encoder *xml.Encoder, name string, value reflect.Value) error {
+	for value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+
+	switch {
+	case !value.IsValid() || (value.Kind() == reflect.Interface && value.IsNil()):
+		return encoder.EncodeElement("", start)
+
+	case (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) && value.Type().Elem().Kind() != reflect.Uint8:
+		for i := 0; i < value.Len(); i++ {
+			err := xmlEncodeElement(encoder, name, value.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case value.Kind() == reflect.Map:
+		var text reflect.Value
+		for _, key := range xmlSortedKeys(value) {
+			keyName := fmt.Sprint(key.Interface())
+			switch {
+			case strings.HasPrefix(keyName, "@"):
+				start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: keyName[1:]}, Value: xmlText(value.MapIndex(key))})
+			case keyName == "#text":
+				text = value.MapIndex(key)
+			}
+		}
+		err := encoder.EncodeToken(start)
+		if err != nil {
+			return err
+		}
+		if text.IsValid() {
+			err = encoder.EncodeToken(xml.CharData(xmlText(text)))
+			if err != nil {
+				return err
+			}
+		}
+		err = xmlEncodeChildren(encoder, value)
+		if err != nil {
+			return err
+		}
+		return encoder.EncodeToken(start.End())
+	}
+
+	return encoder.EncodeElement(xmlText(value), start)
+}
+
+// xmlText returns the value as text, []byte as a string
+func xmlText(value reflect.Value) string {
+	for value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() || (value.Kind() == reflect.Interface && value.IsNil()) {
+		return ""
+	}
+	if bytes, ok := value.Interface().([]byte); ok {
+		return string(bytes)
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+// xmlSortedKeys returns the keys of the map sorted by their text
+func xmlSortedKeys(mapValue reflect.Value) []reflect.Value {
+	keys := mapValue.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
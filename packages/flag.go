@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -40,9 +42,20 @@ func init() {
 		"Uint64":          reflect.ValueOf(flag.Uint64),
 		"Uint64Var":       reflect.ValueOf(flag.Uint64Var),
 		"UintVar":         reflect.ValueOf(flag.UintVar),
+		"UnquoteUsage":    reflect.ValueOf(flag.UnquoteUsage),
 		"Usage":           reflect.ValueOf(flag.Usage),
 		"Var":             reflect.ValueOf(flag.Var),
 		"Visit":           reflect.ValueOf(flag.Visit),
 		"VisitAll":        reflect.ValueOf(flag.VisitAll),
 	}
+	env.PackageTypes["flag"] = map[string]reflect.Type{
+		"ErrorHandling": reflect.TypeOf(flag.ErrorHandling(0)),
+		"Flag":          reflect.TypeOf(flag.Flag{}),
+		"FlagSet":       reflect.TypeOf(flag.FlagSet{}),
+		"Getter":        reflect.TypeOf((*flag.Getter)(nil)).Elem(),
+		"Value":         reflect.TypeOf((*flag.Value)(nil)).Elem(),
+	}
+	flagGo116()
+	flagGo119()
+	flagGo121()
 }
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -29,4 +31,14 @@ func init() {
 		"Sscanf":   reflect.ValueOf(fmt.Sscanf),
 		"Sscanln":  reflect.ValueOf(fmt.Sscanln),
 	}
+	env.PackageTypes["fmt"] = map[string]reflect.Type{
+		"Formatter":  reflect.TypeOf((*fmt.Formatter)(nil)).Elem(),
+		"GoStringer": reflect.TypeOf((*fmt.GoStringer)(nil)).Elem(),
+		"ScanState":  reflect.TypeOf((*fmt.ScanState)(nil)).Elem(),
+		"Scanner":    reflect.TypeOf((*fmt.Scanner)(nil)).Elem(),
+		"State":      reflect.TypeOf((*fmt.State)(nil)).Elem(),
+		"Stringer":   reflect.TypeOf((*fmt.Stringer)(nil)).Elem(),
+	}
+	fmtGo119()
+	fmtGo120()
 }
@@ -0,0 +1,8 @@
+// Package packages defines the Go standard library packages that anko scripts can import.
+// The bindings are generated by cmd/anko-package-gen, run go generate in this directory to regenerate them.
+// The symbols written by hand for a package are added by the function of its helpers file, like netHttpHelpers
+// in net.httpHelpers.go, that the generated init calls after the bindings are set.
+package packages
+
+//go:generate go run ../cmd/anko-package-gen -o . -exclude database/sql.Register archive/tar archive/zip bufio bytes compress/gzip compress/zlib context crypto/hmac crypto/md5 crypto/rand crypto/sha1 crypto/sha256 crypto/sha512 crypto/subtle database/sql encoding/base64 encoding/csv encoding/hex encoding/json encoding/xml errors flag fmt hash/crc32 hash/fnv html/template io io/ioutil log math math/big math/rand net/http/cookiejar os os/exec os/signal path path/filepath regexp runtime sort strconv strings sync text/template time unicode unicode/utf8
+//go:generate go run ../cmd/anko-package-gen -o . -tags !appengine net net/http net/http/httptest net/url
@@ -0,0 +1,29 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"hash/crc32"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["hash/crc32"] = map[string]reflect.Value{
+		"Castagnoli":   reflect.ValueOf(int64(crc32.Castagnoli)),
+		"Checksum":     reflect.ValueOf(crc32.Checksum),
+		"ChecksumIEEE": reflect.ValueOf(crc32.ChecksumIEEE),
+		"IEEE":         reflect.ValueOf(int64(crc32.IEEE)),
+		"IEEETable":    reflect.ValueOf(crc32.IEEETable),
+		"Koopman":      reflect.ValueOf(int64(crc32.Koopman)),
+		"MakeTable":    reflect.ValueOf(crc32.MakeTable),
+		"New":          reflect.ValueOf(crc32.New),
+		"NewIEEE":      reflect.ValueOf(crc32.NewIEEE),
+		"Size":         reflect.ValueOf(crc32.Size),
+		"Update":       reflect.ValueOf(crc32.Update),
+	}
+	env.PackageTypes["hash/crc32"] = map[string]reflect.Type{
+		"Table": reflect.TypeOf(crc32.Table{}),
+	}
+}
@@ -0,0 +1,55 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"html/template"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["html/template"] = map[string]reflect.Value{
+		"ErrAmbigContext":      reflect.ValueOf(template.ErrAmbigContext),
+		"ErrBadHTML":           reflect.ValueOf(template.ErrBadHTML),
+		"ErrBranchEnd":         reflect.ValueOf(template.ErrBranchEnd),
+		"ErrEndContext":        reflect.ValueOf(template.ErrEndContext),
+		"ErrNoSuchTemplate":    reflect.ValueOf(template.ErrNoSuchTemplate),
+		"ErrOutputContext":     reflect.ValueOf(template.ErrOutputContext),
+		"ErrPartialCharset":    reflect.ValueOf(template.ErrPartialCharset),
+		"ErrPartialEscape":     reflect.ValueOf(template.ErrPartialEscape),
+		"ErrPredefinedEscaper": reflect.ValueOf(template.ErrPredefinedEscaper),
+		"ErrRangeLoopReentry":  reflect.ValueOf(template.ErrRangeLoopReentry),
+		"ErrSlashAmbig":        reflect.ValueOf(template.ErrSlashAmbig),
+		"HTMLEscape":           reflect.ValueOf(template.HTMLEscape),
+		"HTMLEscapeString":     reflect.ValueOf(template.HTMLEscapeString),
+		"HTMLEscaper":          reflect.ValueOf(template.HTMLEscaper),
+		"IsTrue":               reflect.ValueOf(template.IsTrue),
+		"JSEscape":             reflect.ValueOf(template.JSEscape),
+		"JSEscapeString":       reflect.ValueOf(template.JSEscapeString),
+		"JSEscaper":            reflect.ValueOf(template.JSEscaper),
+		"Must":                 reflect.ValueOf(template.Must),
+		"New":                  reflect.ValueOf(template.New),
+		"OK":                   reflect.ValueOf(template.OK),
+		"ParseFiles":           reflect.ValueOf(template.ParseFiles),
+		"ParseGlob":            reflect.ValueOf(template.ParseGlob),
+		"URLQueryEscaper":      reflect.ValueOf(template.URLQueryEscaper),
+	}
+	env.PackageTypes["html/template"] = map[string]reflect.Type{
+		"CSS":       reflect.TypeOf(template.CSS("")),
+		"Error":     reflect.TypeOf(template.Error{}),
+		"ErrorCode": reflect.TypeOf(template.ErrorCode(0)),
+		"FuncMap":   reflect.TypeOf(template.FuncMap{}),
+		"HTML":      reflect.TypeOf(template.HTML("")),
+		"HTMLAttr":  reflect.TypeOf(template.HTMLAttr("")),
+		"JS":        reflect.TypeOf(template.JS("")),
+		"JSStr":     reflect.TypeOf(template.JSStr("")),
+		"Srcset":    reflect.TypeOf(template.Srcset("")),
+		"Template":  reflect.TypeOf(template.Template{}),
+		"URL":       reflect.TypeOf(template.URL("")),
+	}
+	htmlTemplateGo116()
+	htmlTemplateGo121()
+	htmlTemplateHelpers()
+}
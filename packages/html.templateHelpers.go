@@ -0,0 +1,13 @@
+package packages
+
+import (
+	"html/template"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+// htmlTemplateHelpers adds the helpers of html/template, called by the generated init after the bindings are set
+func htmlTemplateHelpers() {
+	env.FuncMapTypes[reflect.TypeOf(template.FuncMap{})] = struct{}{}
+}
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -10,6 +12,7 @@ import (
 func init() {
 	env.Packages["io"] = map[string]reflect.Value{
 		"Copy":             reflect.ValueOf(io.Copy),
+		"CopyBuffer":       reflect.ValueOf(io.CopyBuffer),
 		"CopyN":            reflect.ValueOf(io.CopyN),
 		"EOF":              reflect.ValueOf(io.EOF),
 		"ErrClosedPipe":    reflect.ValueOf(io.ErrClosedPipe),
@@ -24,7 +27,39 @@ func init() {
 		"Pipe":             reflect.ValueOf(io.Pipe),
 		"ReadAtLeast":      reflect.ValueOf(io.ReadAtLeast),
 		"ReadFull":         reflect.ValueOf(io.ReadFull),
+		"SeekCurrent":      reflect.ValueOf(io.SeekCurrent),
+		"SeekEnd":          reflect.ValueOf(io.SeekEnd),
+		"SeekStart":        reflect.ValueOf(io.SeekStart),
 		"TeeReader":        reflect.ValueOf(io.TeeReader),
 		"WriteString":      reflect.ValueOf(io.WriteString),
 	}
+	env.PackageTypes["io"] = map[string]reflect.Type{
+		"ByteReader":      reflect.TypeOf((*io.ByteReader)(nil)).Elem(),
+		"ByteScanner":     reflect.TypeOf((*io.ByteScanner)(nil)).Elem(),
+		"ByteWriter":      reflect.TypeOf((*io.ByteWriter)(nil)).Elem(),
+		"Closer":          reflect.TypeOf((*io.Closer)(nil)).Elem(),
+		"LimitedReader":   reflect.TypeOf(io.LimitedReader{}),
+		"PipeReader":      reflect.TypeOf(io.PipeReader{}),
+		"PipeWriter":      reflect.TypeOf(io.PipeWriter{}),
+		"ReadCloser":      reflect.TypeOf((*io.ReadCloser)(nil)).Elem(),
+		"ReadSeeker":      reflect.TypeOf((*io.ReadSeeker)(nil)).Elem(),
+		"ReadWriteCloser": reflect.TypeOf((*io.ReadWriteCloser)(nil)).Elem(),
+		"ReadWriteSeeker": reflect.TypeOf((*io.ReadWriteSeeker)(nil)).Elem(),
+		"ReadWriter":      reflect.TypeOf((*io.ReadWriter)(nil)).Elem(),
+		"Reader":          reflect.TypeOf((*io.Reader)(nil)).Elem(),
+		"ReaderAt":        reflect.TypeOf((*io.ReaderAt)(nil)).Elem(),
+		"ReaderFrom":      reflect.TypeOf((*io.ReaderFrom)(nil)).Elem(),
+		"RuneReader":      reflect.TypeOf((*io.RuneReader)(nil)).Elem(),
+		"RuneScanner":     reflect.TypeOf((*io.RuneScanner)(nil)).Elem(),
+		"SectionReader":   reflect.TypeOf(io.SectionReader{}),
+		"Seeker":          reflect.TypeOf((*io.Seeker)(nil)).Elem(),
+		"StringWriter":    reflect.TypeOf((*io.StringWriter)(nil)).Elem(),
+		"WriteCloser":     reflect.TypeOf((*io.WriteCloser)(nil)).Elem(),
+		"WriteSeeker":     reflect.TypeOf((*io.WriteSeeker)(nil)).Elem(),
+		"Writer":          reflect.TypeOf((*io.Writer)(nil)).Elem(),
+		"WriterAt":        reflect.TypeOf((*io.WriterAt)(nil)).Elem(),
+		"WriterTo":        reflect.TypeOf((*io.WriterTo)(nil)).Elem(),
+	}
+	ioGo116()
+	ioGo120()
 }
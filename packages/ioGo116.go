@@ -0,0 +1,20 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.16
+// +build go1.16
+
+package packages
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func ioGo116() {
+	env.Packages["io"]["Discard"] = reflect.ValueOf(io.Discard)
+	env.Packages["io"]["NopCloser"] = reflect.ValueOf(io.NopCloser)
+	env.Packages["io"]["ReadAll"] = reflect.ValueOf(io.ReadAll)
+	env.PackageTypes["io"]["ReadSeekCloser"] = reflect.TypeOf((*io.ReadSeekCloser)(nil)).Elem()
+}
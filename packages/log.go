@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,21 +11,34 @@ import (
 
 func init() {
 	env.Packages["log"] = map[string]reflect.Value{
-		"Fatal":     reflect.ValueOf(log.Fatal),
-		"Fatalf":    reflect.ValueOf(log.Fatalf),
-		"Fatalln":   reflect.ValueOf(log.Fatalln),
-		"Flags":     reflect.ValueOf(log.Flags),
-		"New":       reflect.ValueOf(log.New),
-		"Output":    reflect.ValueOf(log.Output),
-		"Panic":     reflect.ValueOf(log.Panic),
-		"Panicf":    reflect.ValueOf(log.Panicf),
-		"Panicln":   reflect.ValueOf(log.Panicln),
-		"Prefix":    reflect.ValueOf(log.Prefix),
-		"Print":     reflect.ValueOf(log.Print),
-		"Printf":    reflect.ValueOf(log.Printf),
-		"Println":   reflect.ValueOf(log.Println),
-		"SetFlags":  reflect.ValueOf(log.SetFlags),
-		"SetOutput": reflect.ValueOf(log.SetOutput),
-		"SetPrefix": reflect.ValueOf(log.SetPrefix),
+		"Fatal":         reflect.ValueOf(log.Fatal),
+		"Fatalf":        reflect.ValueOf(log.Fatalf),
+		"Fatalln":       reflect.ValueOf(log.Fatalln),
+		"Flags":         reflect.ValueOf(log.Flags),
+		"LUTC":          reflect.ValueOf(log.LUTC),
+		"Ldate":         reflect.ValueOf(log.Ldate),
+		"Llongfile":     reflect.ValueOf(log.Llongfile),
+		"Lmicroseconds": reflect.ValueOf(log.Lmicroseconds),
+		"Lshortfile":    reflect.ValueOf(log.Lshortfile),
+		"LstdFlags":     reflect.ValueOf(log.LstdFlags),
+		"Ltime":         reflect.ValueOf(log.Ltime),
+		"New":           reflect.ValueOf(log.New),
+		"Output":        reflect.ValueOf(log.Output),
+		"Panic":         reflect.ValueOf(log.Panic),
+		"Panicf":        reflect.ValueOf(log.Panicf),
+		"Panicln":       reflect.ValueOf(log.Panicln),
+		"Prefix":        reflect.ValueOf(log.Prefix),
+		"Print":         reflect.ValueOf(log.Print),
+		"Printf":        reflect.ValueOf(log.Printf),
+		"Println":       reflect.ValueOf(log.Println),
+		"SetFlags":      reflect.ValueOf(log.SetFlags),
+		"SetOutput":     reflect.ValueOf(log.SetOutput),
+		"SetPrefix":     reflect.ValueOf(log.SetPrefix),
+		"Writer":        reflect.ValueOf(log.Writer),
+	}
+	env.PackageTypes["log"] = map[string]reflect.Type{
+		"Logger": reflect.TypeOf(log.Logger{}),
 	}
+	logGo114()
+	logGo116()
 }
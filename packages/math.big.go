@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,15 +11,14 @@ import (
 
 func init() {
 	env.Packages["math/big"] = map[string]reflect.Value{
-		"Above":        reflect.ValueOf(big.Above),
-		"AwayFromZero": reflect.ValueOf(big.AwayFromZero),
-		"Below":        reflect.ValueOf(big.Below),
-		"Exact":        reflect.ValueOf(big.Exact),
-		"Jacobi":       reflect.ValueOf(big.Jacobi),
-		"MaxBase":      reflect.ValueOf(big.MaxBase),
-		"MaxExp":       reflect.ValueOf(big.MaxExp),
-		// TODO: https://github.com/mattn/anko/issues/49
-		// "MaxPrec":       reflect.ValueOf(big.MaxPrec),
+		"Above":         reflect.ValueOf(big.Above),
+		"AwayFromZero":  reflect.ValueOf(big.AwayFromZero),
+		"Below":         reflect.ValueOf(big.Below),
+		"Exact":         reflect.ValueOf(big.Exact),
+		"Jacobi":        reflect.ValueOf(big.Jacobi),
+		"MaxBase":       reflect.ValueOf(big.MaxBase),
+		"MaxExp":        reflect.ValueOf(big.MaxExp),
+		"MaxPrec":       reflect.ValueOf(int64(big.MaxPrec)),
 		"MinExp":        reflect.ValueOf(big.MinExp),
 		"NewFloat":      reflect.ValueOf(big.NewFloat),
 		"NewInt":        reflect.ValueOf(big.NewInt),
@@ -29,4 +30,14 @@ func init() {
 		"ToPositiveInf": reflect.ValueOf(big.ToPositiveInf),
 		"ToZero":        reflect.ValueOf(big.ToZero),
 	}
+	env.PackageTypes["math/big"] = map[string]reflect.Type{
+		"Accuracy":     reflect.TypeOf(big.Accuracy(0)),
+		"ErrNaN":       reflect.TypeOf(big.ErrNaN{}),
+		"Float":        reflect.TypeOf(big.Float{}),
+		"Int":          reflect.TypeOf(big.Int{}),
+		"Rat":          reflect.TypeOf(big.Rat{}),
+		"RoundingMode": reflect.TypeOf(big.RoundingMode(0)),
+		"Word":         reflect.TypeOf(big.Word(0)),
+	}
+	mathBigGo127()
 }
@@ -0,0 +1,20 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.27
+// +build go1.27
+
+package packages
+
+import (
+	"math/big"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func mathBigGo127() {
+	env.Packages["math/big"]["Ceil"] = reflect.ValueOf(big.Ceil)
+	env.Packages["math/big"]["Floor"] = reflect.ValueOf(big.Floor)
+	env.Packages["math/big"]["Round"] = reflect.ValueOf(big.Round)
+	env.Packages["math/big"]["Trunc"] = reflect.ValueOf(big.Trunc)
+}
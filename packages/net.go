@@ -1,3 +1,6 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build !appengine
 // +build !appengine
 
 package packages
@@ -12,6 +15,7 @@ import (
 func init() {
 	env.Packages["net"] = map[string]reflect.Value{
 		"CIDRMask":                   reflect.ValueOf(net.CIDRMask),
+		"DefaultResolver":            reflect.ValueOf(net.DefaultResolver),
 		"Dial":                       reflect.ValueOf(net.Dial),
 		"DialIP":                     reflect.ValueOf(net.DialIP),
 		"DialTCP":                    reflect.ValueOf(net.DialTCP),
@@ -73,4 +77,45 @@ func init() {
 		"ResolveUnixAddr":            reflect.ValueOf(net.ResolveUnixAddr),
 		"SplitHostPort":              reflect.ValueOf(net.SplitHostPort),
 	}
+	env.PackageTypes["net"] = map[string]reflect.Type{
+		"Addr":                reflect.TypeOf((*net.Addr)(nil)).Elem(),
+		"AddrError":           reflect.TypeOf(net.AddrError{}),
+		"Buffers":             reflect.TypeOf(net.Buffers{}),
+		"Conn":                reflect.TypeOf((*net.Conn)(nil)).Elem(),
+		"DNSConfigError":      reflect.TypeOf(net.DNSConfigError{}),
+		"DNSError":            reflect.TypeOf(net.DNSError{}),
+		"Dialer":              reflect.TypeOf(net.Dialer{}),
+		"Error":               reflect.TypeOf((*net.Error)(nil)).Elem(),
+		"Flags":               reflect.TypeOf(net.Flags(0)),
+		"HardwareAddr":        reflect.TypeOf(net.HardwareAddr{}),
+		"IP":                  reflect.TypeOf(net.IP{}),
+		"IPAddr":              reflect.TypeOf(net.IPAddr{}),
+		"IPConn":              reflect.TypeOf(net.IPConn{}),
+		"IPMask":              reflect.TypeOf(net.IPMask{}),
+		"IPNet":               reflect.TypeOf(net.IPNet{}),
+		"Interface":           reflect.TypeOf(net.Interface{}),
+		"InvalidAddrError":    reflect.TypeOf(net.InvalidAddrError("")),
+		"ListenConfig":        reflect.TypeOf(net.ListenConfig{}),
+		"Listener":            reflect.TypeOf((*net.Listener)(nil)).Elem(),
+		"MX":                  reflect.TypeOf(net.MX{}),
+		"NS":                  reflect.TypeOf(net.NS{}),
+		"OpError":             reflect.TypeOf(net.OpError{}),
+		"PacketConn":          reflect.TypeOf((*net.PacketConn)(nil)).Elem(),
+		"ParseError":          reflect.TypeOf(net.ParseError{}),
+		"Resolver":            reflect.TypeOf(net.Resolver{}),
+		"SRV":                 reflect.TypeOf(net.SRV{}),
+		"TCPAddr":             reflect.TypeOf(net.TCPAddr{}),
+		"TCPConn":             reflect.TypeOf(net.TCPConn{}),
+		"TCPListener":         reflect.TypeOf(net.TCPListener{}),
+		"UDPAddr":             reflect.TypeOf(net.UDPAddr{}),
+		"UDPConn":             reflect.TypeOf(net.UDPConn{}),
+		"UnixAddr":            reflect.TypeOf(net.UnixAddr{}),
+		"UnixConn":            reflect.TypeOf(net.UnixConn{}),
+		"UnixListener":        reflect.TypeOf(net.UnixListener{}),
+		"UnknownNetworkError": reflect.TypeOf(net.UnknownNetworkError("")),
+	}
+	netGo116()
+	netGo118()
+	netGo120()
+	netGo123()
 }
@@ -0,0 +1,30 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build !appengine
+// +build !appengine
+
+package packages
+
+import (
+	"net/http/httptest"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["net/http/httptest"] = map[string]reflect.Value{
+		"DefaultRemoteAddr":  reflect.ValueOf(httptest.DefaultRemoteAddr),
+		"NewRecorder":        reflect.ValueOf(httptest.NewRecorder),
+		"NewRequest":         reflect.ValueOf(httptest.NewRequest),
+		"NewServer":          reflect.ValueOf(httptest.NewServer),
+		"NewTLSServer":       reflect.ValueOf(httptest.NewTLSServer),
+		"NewUnstartedServer": reflect.ValueOf(httptest.NewUnstartedServer),
+	}
+	env.PackageTypes["net/http/httptest"] = map[string]reflect.Type{
+		"ResponseRecorder": reflect.TypeOf(httptest.ResponseRecorder{}),
+		"Server":           reflect.TypeOf(httptest.Server{}),
+	}
+	netHttpHttptestGo123()
+	netHttpHttptestGo127()
+}
@@ -0,0 +1,17 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.23 && !appengine
+// +build go1.23,!appengine
+
+package packages
+
+import (
+	"net/http/httptest"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func netHttpHttptestGo123() {
+	env.Packages["net/http/httptest"]["NewRequestWithContext"] = reflect.ValueOf(httptest.NewRequestWithContext)
+}
@@ -0,0 +1,17 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.27 && !appengine
+// +build go1.27,!appengine
+
+package packages
+
+import (
+	"net/http/httptest"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func netHttpHttptestGo127() {
+	env.Packages["net/http/httptest"]["NewTestServer"] = reflect.ValueOf(httptest.NewTestServer)
+}
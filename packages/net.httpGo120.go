@@ -0,0 +1,18 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.20 && !appengine
+// +build go1.20,!appengine
+
+package packages
+
+import (
+	"net/http"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func netHttpGo120() {
+	env.Packages["net/http"]["NewResponseController"] = reflect.ValueOf(http.NewResponseController)
+	env.PackageTypes["net/http"]["ResponseController"] = reflect.TypeOf(http.ResponseController{})
+}
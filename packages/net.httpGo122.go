@@ -0,0 +1,19 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.22 && !appengine
+// +build go1.22,!appengine
+
+package packages
+
+import (
+	"net/http"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func netHttpGo122() {
+	env.Packages["net/http"]["FileServerFS"] = reflect.ValueOf(http.FileServerFS)
+	env.Packages["net/http"]["NewFileTransportFS"] = reflect.ValueOf(http.NewFileTransportFS)
+	env.Packages["net/http"]["ServeFileFS"] = reflect.ValueOf(http.ServeFileFS)
+}
@@ -0,0 +1,18 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.23 && !appengine
+// +build go1.23,!appengine
+
+package packages
+
+import (
+	"net/http"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func netHttpGo123() {
+	env.Packages["net/http"]["ParseCookie"] = reflect.ValueOf(http.ParseCookie)
+	env.Packages["net/http"]["ParseSetCookie"] = reflect.ValueOf(http.ParseSetCookie)
+}
@@ -0,0 +1,18 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.24 && !appengine
+// +build go1.24,!appengine
+
+package packages
+
+import (
+	"net/http"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func netHttpGo124() {
+	env.PackageTypes["net/http"]["HTTP2Config"] = reflect.TypeOf(http.HTTP2Config{})
+	env.PackageTypes["net/http"]["Protocols"] = reflect.TypeOf(http.Protocols{})
+}
@@ -0,0 +1,18 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.25 && !appengine
+// +build go1.25,!appengine
+
+package packages
+
+import (
+	"net/http"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func netHttpGo125() {
+	env.Packages["net/http"]["NewCrossOriginProtection"] = reflect.ValueOf(http.NewCrossOriginProtection)
+	env.PackageTypes["net/http"]["CrossOriginProtection"] = reflect.TypeOf(http.CrossOriginProtection{})
+}
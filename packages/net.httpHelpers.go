@@ -0,0 +1,21 @@
+// +build !appengine
+
+package packages
+
+import (
+	"net/http"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+// netHttpHelpers adds the helpers of net/http, called by the generated init after the bindings are set
+func netHttpHelpers() {
+	// the type HandlerFunc is also a function so scripts can make a Handler of a script function
+	env.Packages["net/http"]["HandlerFunc"] = reflect.ValueOf(httpHandlerFunc)
+}
+
+// httpHandlerFunc returns the handler function as a Handler
+func httpHandlerFunc(handler func(http.ResponseWriter, *http.Request)) http.Handler {
+	return http.HandlerFunc(handler)
+}
@@ -1,3 +1,6 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build !appengine
 // +build !appengine
 
 package packages
@@ -11,17 +14,23 @@ import (
 
 func init() {
 	env.Packages["net/url"] = map[string]reflect.Value{
-		"QueryEscape":     reflect.ValueOf(url.QueryEscape),
-		"QueryUnescape":   reflect.ValueOf(url.QueryUnescape),
 		"Parse":           reflect.ValueOf(url.Parse),
+		"ParseQuery":      reflect.ValueOf(url.ParseQuery),
 		"ParseRequestURI": reflect.ValueOf(url.ParseRequestURI),
+		"PathEscape":      reflect.ValueOf(url.PathEscape),
+		"PathUnescape":    reflect.ValueOf(url.PathUnescape),
+		"QueryEscape":     reflect.ValueOf(url.QueryEscape),
+		"QueryUnescape":   reflect.ValueOf(url.QueryUnescape),
 		"User":            reflect.ValueOf(url.User),
 		"UserPassword":    reflect.ValueOf(url.UserPassword),
-		"ParseQuery":      reflect.ValueOf(url.ParseQuery),
 	}
 	env.PackageTypes["net/url"] = map[string]reflect.Type{
-		"Error":  reflect.TypeOf(url.Error{}),
-		"URL":    reflect.TypeOf(url.URL{}),
-		"Values": reflect.TypeOf(url.Values{}),
+		"Error":            reflect.TypeOf(url.Error{}),
+		"EscapeError":      reflect.TypeOf(url.EscapeError("")),
+		"InvalidHostError": reflect.TypeOf(url.InvalidHostError("")),
+		"URL":              reflect.TypeOf(url.URL{}),
+		"Userinfo":         reflect.TypeOf(url.Userinfo{}),
+		"Values":           reflect.TypeOf(url.Values{}),
 	}
+	netUrlGo119()
 }
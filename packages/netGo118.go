@@ -0,0 +1,18 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.18 && !appengine
+// +build go1.18,!appengine
+
+package packages
+
+import (
+	"net"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func netGo118() {
+	env.Packages["net"]["TCPAddrFromAddrPort"] = reflect.ValueOf(net.TCPAddrFromAddrPort)
+	env.Packages["net"]["UDPAddrFromAddrPort"] = reflect.ValueOf(net.UDPAddrFromAddrPort)
+}
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,8 +11,16 @@ import (
 
 func init() {
 	env.Packages["os/exec"] = map[string]reflect.Value{
-		"ErrNotFound": reflect.ValueOf(exec.ErrNotFound),
-		"LookPath":    reflect.ValueOf(exec.LookPath),
-		"Command":     reflect.ValueOf(exec.Command),
+		"Command":        reflect.ValueOf(exec.Command),
+		"CommandContext": reflect.ValueOf(exec.CommandContext),
+		"ErrNotFound":    reflect.ValueOf(exec.ErrNotFound),
+		"LookPath":       reflect.ValueOf(exec.LookPath),
+	}
+	env.PackageTypes["os/exec"] = map[string]reflect.Type{
+		"Cmd":       reflect.TypeOf(exec.Cmd{}),
+		"Error":     reflect.TypeOf(exec.Error{}),
+		"ExitError": reflect.TypeOf(exec.ExitError{}),
 	}
+	osExecGo119()
+	osExecGo120()
 }
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -18,10 +20,13 @@ func init() {
 		"Create":            reflect.ValueOf(os.Create),
 		"DevNull":           reflect.ValueOf(os.DevNull),
 		"Environ":           reflect.ValueOf(os.Environ),
+		"ErrClosed":         reflect.ValueOf(os.ErrClosed),
 		"ErrExist":          reflect.ValueOf(os.ErrExist),
 		"ErrInvalid":        reflect.ValueOf(os.ErrInvalid),
+		"ErrNoDeadline":     reflect.ValueOf(os.ErrNoDeadline),
 		"ErrNotExist":       reflect.ValueOf(os.ErrNotExist),
 		"ErrPermission":     reflect.ValueOf(os.ErrPermission),
+		"Executable":        reflect.ValueOf(os.Executable),
 		"Exit":              reflect.ValueOf(os.Exit),
 		"Expand":            reflect.ValueOf(os.Expand),
 		"ExpandEnv":         reflect.ValueOf(os.ExpandEnv),
@@ -33,6 +38,7 @@ func init() {
 		"Getgroups":         reflect.ValueOf(os.Getgroups),
 		"Getpagesize":       reflect.ValueOf(os.Getpagesize),
 		"Getpid":            reflect.ValueOf(os.Getpid),
+		"Getppid":           reflect.ValueOf(os.Getppid),
 		"Getuid":            reflect.ValueOf(os.Getuid),
 		"Getwd":             reflect.ValueOf(os.Getwd),
 		"Hostname":          reflect.ValueOf(os.Hostname),
@@ -41,9 +47,11 @@ func init() {
 		"IsNotExist":        reflect.ValueOf(os.IsNotExist),
 		"IsPathSeparator":   reflect.ValueOf(os.IsPathSeparator),
 		"IsPermission":      reflect.ValueOf(os.IsPermission),
+		"IsTimeout":         reflect.ValueOf(os.IsTimeout),
 		"Kill":              reflect.ValueOf(os.Kill),
 		"Lchown":            reflect.ValueOf(os.Lchown),
 		"Link":              reflect.ValueOf(os.Link),
+		"LookupEnv":         reflect.ValueOf(os.LookupEnv),
 		"Lstat":             reflect.ValueOf(os.Lstat),
 		"Mkdir":             reflect.ValueOf(os.Mkdir),
 		"MkdirAll":          reflect.ValueOf(os.MkdirAll),
@@ -52,6 +60,7 @@ func init() {
 		"ModeDevice":        reflect.ValueOf(os.ModeDevice),
 		"ModeDir":           reflect.ValueOf(os.ModeDir),
 		"ModeExclusive":     reflect.ValueOf(os.ModeExclusive),
+		"ModeIrregular":     reflect.ValueOf(os.ModeIrregular),
 		"ModeNamedPipe":     reflect.ValueOf(os.ModeNamedPipe),
 		"ModePerm":          reflect.ValueOf(os.ModePerm),
 		"ModeSetgid":        reflect.ValueOf(os.ModeSetgid),
@@ -93,10 +102,26 @@ func init() {
 		"Symlink":           reflect.ValueOf(os.Symlink),
 		"TempDir":           reflect.ValueOf(os.TempDir),
 		"Truncate":          reflect.ValueOf(os.Truncate),
+		"Unsetenv":          reflect.ValueOf(os.Unsetenv),
+		"UserCacheDir":      reflect.ValueOf(os.UserCacheDir),
+		"UserConfigDir":     reflect.ValueOf(os.UserConfigDir),
+		"UserHomeDir":       reflect.ValueOf(os.UserHomeDir),
 	}
-	var signal os.Signal
 	env.PackageTypes["os"] = map[string]reflect.Type{
-		"Signal": reflect.TypeOf(&signal).Elem(),
+		"File":         reflect.TypeOf(os.File{}),
+		"FileInfo":     reflect.TypeOf((*os.FileInfo)(nil)).Elem(),
+		"FileMode":     reflect.TypeOf(os.FileMode(0)),
+		"LinkError":    reflect.TypeOf(os.LinkError{}),
+		"PathError":    reflect.TypeOf(os.PathError{}),
+		"ProcAttr":     reflect.TypeOf(os.ProcAttr{}),
+		"Process":      reflect.TypeOf(os.Process{}),
+		"ProcessState": reflect.TypeOf(os.ProcessState{}),
+		"Signal":       reflect.TypeOf((*os.Signal)(nil)).Elem(),
+		"SyscallError": reflect.TypeOf(os.SyscallError{}),
 	}
-	osNotAppEngine()
+	osGo115()
+	osGo116()
+	osGo123()
+	osGo124()
+	osGo126()
 }
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,7 +11,11 @@ import (
 
 func init() {
 	env.Packages["os/signal"] = map[string]reflect.Value{
-		"Notify": reflect.ValueOf(signal.Notify),
-		"Stop":   reflect.ValueOf(signal.Stop),
+		"Ignore":  reflect.ValueOf(signal.Ignore),
+		"Ignored": reflect.ValueOf(signal.Ignored),
+		"Notify":  reflect.ValueOf(signal.Notify),
+		"Reset":   reflect.ValueOf(signal.Reset),
+		"Stop":    reflect.ValueOf(signal.Stop),
 	}
+	osSignalGo116()
 }
@@ -0,0 +1,24 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.16
+// +build go1.16
+
+package packages
+
+import (
+	"os"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func osGo116() {
+	env.Packages["os"]["CreateTemp"] = reflect.ValueOf(os.CreateTemp)
+	env.Packages["os"]["DirFS"] = reflect.ValueOf(os.DirFS)
+	env.Packages["os"]["ErrProcessDone"] = reflect.ValueOf(os.ErrProcessDone)
+	env.Packages["os"]["MkdirTemp"] = reflect.ValueOf(os.MkdirTemp)
+	env.Packages["os"]["ReadDir"] = reflect.ValueOf(os.ReadDir)
+	env.Packages["os"]["ReadFile"] = reflect.ValueOf(os.ReadFile)
+	env.Packages["os"]["WriteFile"] = reflect.ValueOf(os.WriteFile)
+	env.PackageTypes["os"]["DirEntry"] = reflect.TypeOf((*os.DirEntry)(nil)).Elem()
+}
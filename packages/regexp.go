@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,13 +11,16 @@ import (
 
 func init() {
 	env.Packages["regexp"] = map[string]reflect.Value{
+		"Compile":          reflect.ValueOf(regexp.Compile),
+		"CompilePOSIX":     reflect.ValueOf(regexp.CompilePOSIX),
 		"Match":            reflect.ValueOf(regexp.Match),
 		"MatchReader":      reflect.ValueOf(regexp.MatchReader),
 		"MatchString":      reflect.ValueOf(regexp.MatchString),
-		"QuoteMeta":        reflect.ValueOf(regexp.QuoteMeta),
-		"Compile":          reflect.ValueOf(regexp.Compile),
-		"CompilePOSIX":     reflect.ValueOf(regexp.CompilePOSIX),
 		"MustCompile":      reflect.ValueOf(regexp.MustCompile),
 		"MustCompilePOSIX": reflect.ValueOf(regexp.MustCompilePOSIX),
+		"QuoteMeta":        reflect.ValueOf(regexp.QuoteMeta),
+	}
+	env.PackageTypes["regexp"] = map[string]reflect.Type{
+		"Regexp": reflect.TypeOf(regexp.Regexp{}),
 	}
 }
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -7,17 +9,6 @@ import (
 	"github.com/mattn/anko/env"
 )
 
-// SortFuncsStruct provides functions to be used with Sort
-type SortFuncsStruct struct {
-	LenFunc  func() int
-	LessFunc func(i, j int) bool
-	SwapFunc func(i, j int)
-}
-
-func (s SortFuncsStruct) Len() int           { return s.LenFunc() }
-func (s SortFuncsStruct) Less(i, j int) bool { return s.LessFunc(i, j) }
-func (s SortFuncsStruct) Swap(i, j int)      { s.SwapFunc(i, j) }
-
 func init() {
 	env.Packages["sort"] = map[string]reflect.Value{
 		"Float64s":          reflect.ValueOf(sort.Float64s),
@@ -25,20 +16,25 @@ func init() {
 		"Ints":              reflect.ValueOf(sort.Ints),
 		"IntsAreSorted":     reflect.ValueOf(sort.IntsAreSorted),
 		"IsSorted":          reflect.ValueOf(sort.IsSorted),
+		"Reverse":           reflect.ValueOf(sort.Reverse),
 		"Search":            reflect.ValueOf(sort.Search),
 		"SearchFloat64s":    reflect.ValueOf(sort.SearchFloat64s),
 		"SearchInts":        reflect.ValueOf(sort.SearchInts),
 		"SearchStrings":     reflect.ValueOf(sort.SearchStrings),
+		"Slice":             reflect.ValueOf(sort.Slice),
+		"SliceIsSorted":     reflect.ValueOf(sort.SliceIsSorted),
+		"SliceStable":       reflect.ValueOf(sort.SliceStable),
 		"Sort":              reflect.ValueOf(sort.Sort),
 		"Stable":            reflect.ValueOf(sort.Stable),
 		"Strings":           reflect.ValueOf(sort.Strings),
 		"StringsAreSorted":  reflect.ValueOf(sort.StringsAreSorted),
 	}
 	env.PackageTypes["sort"] = map[string]reflect.Type{
-		"Float64Slice":    reflect.TypeOf(sort.Float64Slice{}),
-		"IntSlice":        reflect.TypeOf(sort.IntSlice{}),
-		"StringSlice":     reflect.TypeOf(sort.StringSlice{}),
-		"SortFuncsStruct": reflect.TypeOf(&SortFuncsStruct{}),
+		"Float64Slice": reflect.TypeOf(sort.Float64Slice{}),
+		"IntSlice":     reflect.TypeOf(sort.IntSlice{}),
+		"Interface":    reflect.TypeOf((*sort.Interface)(nil)).Elem(),
+		"StringSlice":  reflect.TypeOf(sort.StringSlice{}),
 	}
-	sortGo18()
+	sortGo119()
+	sortHelpers()
 }
@@ -0,0 +1,23 @@
+package packages
+
+import (
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+// SortFuncsStruct provides functions to be used with Sort
+type SortFuncsStruct struct {
+	LenFunc  func() int
+	LessFunc func(i, j int) bool
+	SwapFunc func(i, j int)
+}
+
+func (s SortFuncsStruct) Len() int           { return s.LenFunc() }
+func (s SortFuncsStruct) Less(i, j int) bool { return s.LessFunc(i, j) }
+func (s SortFuncsStruct) Swap(i, j int)      { s.SwapFunc(i, j) }
+
+// sortHelpers adds the helpers of sort, called by the generated init after the bindings are set
+func sortHelpers() {
+	env.PackageTypes["sort"]["SortFuncsStruct"] = reflect.TypeOf(&SortFuncsStruct{})
+}
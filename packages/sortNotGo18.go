@@ -1,5 +0,0 @@
-// +build !go1.8
-
-package packages
-
-func sortGo18() {}
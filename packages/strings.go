@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,6 +11,7 @@ import (
 
 func init() {
 	env.Packages["strings"] = map[string]reflect.Value{
+		"Compare":        reflect.ValueOf(strings.Compare),
 		"Contains":       reflect.ValueOf(strings.Contains),
 		"ContainsAny":    reflect.ValueOf(strings.ContainsAny),
 		"ContainsRune":   reflect.ValueOf(strings.ContainsRune),
@@ -26,12 +29,14 @@ func init() {
 		"Join":           reflect.ValueOf(strings.Join),
 		"LastIndex":      reflect.ValueOf(strings.LastIndex),
 		"LastIndexAny":   reflect.ValueOf(strings.LastIndexAny),
+		"LastIndexByte":  reflect.ValueOf(strings.LastIndexByte),
 		"LastIndexFunc":  reflect.ValueOf(strings.LastIndexFunc),
 		"Map":            reflect.ValueOf(strings.Map),
 		"NewReader":      reflect.ValueOf(strings.NewReader),
 		"NewReplacer":    reflect.ValueOf(strings.NewReplacer),
 		"Repeat":         reflect.ValueOf(strings.Repeat),
 		"Replace":        reflect.ValueOf(strings.Replace),
+		"ReplaceAll":     reflect.ValueOf(strings.ReplaceAll),
 		"Split":          reflect.ValueOf(strings.Split),
 		"SplitAfter":     reflect.ValueOf(strings.SplitAfter),
 		"SplitAfterN":    reflect.ValueOf(strings.SplitAfterN),
@@ -43,6 +48,7 @@ func init() {
 		"ToTitleSpecial": reflect.ValueOf(strings.ToTitleSpecial),
 		"ToUpper":        reflect.ValueOf(strings.ToUpper),
 		"ToUpperSpecial": reflect.ValueOf(strings.ToUpperSpecial),
+		"ToValidUTF8":    reflect.ValueOf(strings.ToValidUTF8),
 		"Trim":           reflect.ValueOf(strings.Trim),
 		"TrimFunc":       reflect.ValueOf(strings.TrimFunc),
 		"TrimLeft":       reflect.ValueOf(strings.TrimLeft),
@@ -53,5 +59,14 @@ func init() {
 		"TrimSpace":      reflect.ValueOf(strings.TrimSpace),
 		"TrimSuffix":     reflect.ValueOf(strings.TrimSuffix),
 	}
-	stringsGo110()
+	env.PackageTypes["strings"] = map[string]reflect.Type{
+		"Builder":  reflect.TypeOf(strings.Builder{}),
+		"Reader":   reflect.TypeOf(strings.Reader{}),
+		"Replacer": reflect.TypeOf(strings.Replacer{}),
+	}
+	stringsGo118()
+	stringsGo120()
+	stringsGo121()
+	stringsGo124()
+	stringsGo127()
 }
@@ -0,0 +1,21 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.24
+// +build go1.24
+
+package packages
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/mattn/anko/env"
+)
+
+func stringsGo124() {
+	env.Packages["strings"]["FieldsFuncSeq"] = reflect.ValueOf(strings.FieldsFuncSeq)
+	env.Packages["strings"]["FieldsSeq"] = reflect.ValueOf(strings.FieldsSeq)
+	env.Packages["strings"]["Lines"] = reflect.ValueOf(strings.Lines)
+	env.Packages["strings"]["SplitAfterSeq"] = reflect.ValueOf(strings.SplitAfterSeq)
+	env.Packages["strings"]["SplitSeq"] = reflect.ValueOf(strings.SplitSeq)
+}
@@ -1,5 +0,0 @@
-// +build !go1.9
-
-package packages
-
-func syncGo19() {}
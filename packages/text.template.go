@@ -0,0 +1,34 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"reflect"
+	"text/template"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["text/template"] = map[string]reflect.Value{
+		"HTMLEscape":       reflect.ValueOf(template.HTMLEscape),
+		"HTMLEscapeString": reflect.ValueOf(template.HTMLEscapeString),
+		"HTMLEscaper":      reflect.ValueOf(template.HTMLEscaper),
+		"IsTrue":           reflect.ValueOf(template.IsTrue),
+		"JSEscape":         reflect.ValueOf(template.JSEscape),
+		"JSEscapeString":   reflect.ValueOf(template.JSEscapeString),
+		"JSEscaper":        reflect.ValueOf(template.JSEscaper),
+		"Must":             reflect.ValueOf(template.Must),
+		"New":              reflect.ValueOf(template.New),
+		"ParseFiles":       reflect.ValueOf(template.ParseFiles),
+		"ParseGlob":        reflect.ValueOf(template.ParseGlob),
+		"URLQueryEscaper":  reflect.ValueOf(template.URLQueryEscaper),
+	}
+	env.PackageTypes["text/template"] = map[string]reflect.Type{
+		"ExecError": reflect.TypeOf(template.ExecError{}),
+		"FuncMap":   reflect.TypeOf(template.FuncMap{}),
+		"Template":  reflect.TypeOf(template.Template{}),
+	}
+	textTemplateGo116()
+	textTemplateHelpers()
+}
@@ -0,0 +1,13 @@
+package packages
+
+import (
+	"reflect"
+	"text/template"
+
+	"github.com/mattn/anko/env"
+)
+
+// textTemplateHelpers adds the helpers of text/template, called by the generated init after the bindings are set
+func textTemplateHelpers() {
+	env.FuncMapTypes[reflect.TypeOf(template.FuncMap{})] = struct{}{}
+}
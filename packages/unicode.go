@@ -0,0 +1,298 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"reflect"
+	"unicode"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["unicode"] = map[string]reflect.Value{
+		"ASCII_Hex_Digit":                    reflect.ValueOf(unicode.ASCII_Hex_Digit),
+		"Adlam":                              reflect.ValueOf(unicode.Adlam),
+		"Ahom":                               reflect.ValueOf(unicode.Ahom),
+		"Anatolian_Hieroglyphs":              reflect.ValueOf(unicode.Anatolian_Hieroglyphs),
+		"Arabic":                             reflect.ValueOf(unicode.Arabic),
+		"Armenian":                           reflect.ValueOf(unicode.Armenian),
+		"Avestan":                            reflect.ValueOf(unicode.Avestan),
+		"AzeriCase":                          reflect.ValueOf(unicode.AzeriCase),
+		"Balinese":                           reflect.ValueOf(unicode.Balinese),
+		"Bamum":                              reflect.ValueOf(unicode.Bamum),
+		"Bassa_Vah":                          reflect.ValueOf(unicode.Bassa_Vah),
+		"Batak":                              reflect.ValueOf(unicode.Batak),
+		"Bengali":                            reflect.ValueOf(unicode.Bengali),
+		"Bhaiksuki":                          reflect.ValueOf(unicode.Bhaiksuki),
+		"Bidi_Control":                       reflect.ValueOf(unicode.Bidi_Control),
+		"Bopomofo":                           reflect.ValueOf(unicode.Bopomofo),
+		"Brahmi":                             reflect.ValueOf(unicode.Brahmi),
+		"Braille":                            reflect.ValueOf(unicode.Braille),
+		"Buginese":                           reflect.ValueOf(unicode.Buginese),
+		"Buhid":                              reflect.ValueOf(unicode.Buhid),
+		"C":                                  reflect.ValueOf(unicode.C),
+		"Canadian_Aboriginal":                reflect.ValueOf(unicode.Canadian_Aboriginal),
+		"Carian":                             reflect.ValueOf(unicode.Carian),
+		"CaseRanges":                         reflect.ValueOf(unicode.CaseRanges),
+		"Categories":                         reflect.ValueOf(unicode.Categories),
+		"Caucasian_Albanian":                 reflect.ValueOf(unicode.Caucasian_Albanian),
+		"Cc":                                 reflect.ValueOf(unicode.Cc),
+		"Cf":                                 reflect.ValueOf(unicode.Cf),
+		"Chakma":                             reflect.ValueOf(unicode.Chakma),
+		"Cham":                               reflect.ValueOf(unicode.Cham),
+		"Cherokee":                           reflect.ValueOf(unicode.Cherokee),
+		"Co":                                 reflect.ValueOf(unicode.Co),
+		"Common":                             reflect.ValueOf(unicode.Common),
+		"Coptic":                             reflect.ValueOf(unicode.Coptic),
+		"Cs":                                 reflect.ValueOf(unicode.Cs),
+		"Cuneiform":                          reflect.ValueOf(unicode.Cuneiform),
+		"Cypriot":                            reflect.ValueOf(unicode.Cypriot),
+		"Cyrillic":                           reflect.ValueOf(unicode.Cyrillic),
+		"Dash":                               reflect.ValueOf(unicode.Dash),
+		"Deprecated":                         reflect.ValueOf(unicode.Deprecated),
+		"Deseret":                            reflect.ValueOf(unicode.Deseret),
+		"Devanagari":                         reflect.ValueOf(unicode.Devanagari),
+		"Diacritic":                          reflect.ValueOf(unicode.Diacritic),
+		"Digit":                              reflect.ValueOf(unicode.Digit),
+		"Dogra":                              reflect.ValueOf(unicode.Dogra),
+		"Duployan":                           reflect.ValueOf(unicode.Duployan),
+		"Egyptian_Hieroglyphs":               reflect.ValueOf(unicode.Egyptian_Hieroglyphs),
+		"Elbasan":                            reflect.ValueOf(unicode.Elbasan),
+		"Ethiopic":                           reflect.ValueOf(unicode.Ethiopic),
+		"Extender":                           reflect.ValueOf(unicode.Extender),
+		"FoldCategory":                       reflect.ValueOf(unicode.FoldCategory),
+		"FoldScript":                         reflect.ValueOf(unicode.FoldScript),
+		"Georgian":                           reflect.ValueOf(unicode.Georgian),
+		"Glagolitic":                         reflect.ValueOf(unicode.Glagolitic),
+		"Gothic":                             reflect.ValueOf(unicode.Gothic),
+		"Grantha":                            reflect.ValueOf(unicode.Grantha),
+		"GraphicRanges":                      reflect.ValueOf(unicode.GraphicRanges),
+		"Greek":                              reflect.ValueOf(unicode.Greek),
+		"Gujarati":                           reflect.ValueOf(unicode.Gujarati),
+		"Gunjala_Gondi":                      reflect.ValueOf(unicode.Gunjala_Gondi),
+		"Gurmukhi":                           reflect.ValueOf(unicode.Gurmukhi),
+		"Han":                                reflect.ValueOf(unicode.Han),
+		"Hangul":                             reflect.ValueOf(unicode.Hangul),
+		"Hanifi_Rohingya":                    reflect.ValueOf(unicode.Hanifi_Rohingya),
+		"Hanunoo":                            reflect.ValueOf(unicode.Hanunoo),
+		"Hatran":                             reflect.ValueOf(unicode.Hatran),
+		"Hebrew":                             reflect.ValueOf(unicode.Hebrew),
+		"Hex_Digit":                          reflect.ValueOf(unicode.Hex_Digit),
+		"Hiragana":                           reflect.ValueOf(unicode.Hiragana),
+		"Hyphen":                             reflect.ValueOf(unicode.Hyphen),
+		"IDS_Binary_Operator":                reflect.ValueOf(unicode.IDS_Binary_Operator),
+		"IDS_Trinary_Operator":               reflect.ValueOf(unicode.IDS_Trinary_Operator),
+		"Ideographic":                        reflect.ValueOf(unicode.Ideographic),
+		"Imperial_Aramaic":                   reflect.ValueOf(unicode.Imperial_Aramaic),
+		"In":                                 reflect.ValueOf(unicode.In),
+		"Inherited":                          reflect.ValueOf(unicode.Inherited),
+		"Inscriptional_Pahlavi":              reflect.ValueOf(unicode.Inscriptional_Pahlavi),
+		"Inscriptional_Parthian":             reflect.ValueOf(unicode.Inscriptional_Parthian),
+		"Is":                                 reflect.ValueOf(unicode.Is),
+		"IsControl":                          reflect.ValueOf(unicode.IsControl),
+		"IsDigit":                            reflect.ValueOf(unicode.IsDigit),
+		"IsGraphic":                          reflect.ValueOf(unicode.IsGraphic),
+		"IsLetter":                           reflect.ValueOf(unicode.IsLetter),
+		"IsLower":                            reflect.ValueOf(unicode.IsLower),
+		"IsMark":                             reflect.ValueOf(unicode.IsMark),
+		"IsNumber":                           reflect.ValueOf(unicode.IsNumber),
+		"IsOneOf":                            reflect.ValueOf(unicode.IsOneOf),
+		"IsPrint":                            reflect.ValueOf(unicode.IsPrint),
+		"IsPunct":                            reflect.ValueOf(unicode.IsPunct),
+		"IsSpace":                            reflect.ValueOf(unicode.IsSpace),
+		"IsSymbol":                           reflect.ValueOf(unicode.IsSymbol),
+		"IsTitle":                            reflect.ValueOf(unicode.IsTitle),
+		"IsUpper":                            reflect.ValueOf(unicode.IsUpper),
+		"Javanese":                           reflect.ValueOf(unicode.Javanese),
+		"Join_Control":                       reflect.ValueOf(unicode.Join_Control),
+		"Kaithi":                             reflect.ValueOf(unicode.Kaithi),
+		"Kannada":                            reflect.ValueOf(unicode.Kannada),
+		"Katakana":                           reflect.ValueOf(unicode.Katakana),
+		"Kayah_Li":                           reflect.ValueOf(unicode.Kayah_Li),
+		"Kharoshthi":                         reflect.ValueOf(unicode.Kharoshthi),
+		"Khmer":                              reflect.ValueOf(unicode.Khmer),
+		"Khojki":                             reflect.ValueOf(unicode.Khojki),
+		"Khudawadi":                          reflect.ValueOf(unicode.Khudawadi),
+		"L":                                  reflect.ValueOf(unicode.L),
+		"Lao":                                reflect.ValueOf(unicode.Lao),
+		"Latin":                              reflect.ValueOf(unicode.Latin),
+		"Lepcha":                             reflect.ValueOf(unicode.Lepcha),
+		"Letter":                             reflect.ValueOf(unicode.Letter),
+		"Limbu":                              reflect.ValueOf(unicode.Limbu),
+		"Linear_A":                           reflect.ValueOf(unicode.Linear_A),
+		"Linear_B":                           reflect.ValueOf(unicode.Linear_B),
+		"Lisu":                               reflect.ValueOf(unicode.Lisu),
+		"Ll":                                 reflect.ValueOf(unicode.Ll),
+		"Lm":                                 reflect.ValueOf(unicode.Lm),
+		"Lo":                                 reflect.ValueOf(unicode.Lo),
+		"Logical_Order_Exception":            reflect.ValueOf(unicode.Logical_Order_Exception),
+		"Lower":                              reflect.ValueOf(unicode.Lower),
+		"LowerCase":                          reflect.ValueOf(unicode.LowerCase),
+		"Lt":                                 reflect.ValueOf(unicode.Lt),
+		"Lu":                                 reflect.ValueOf(unicode.Lu),
+		"Lycian":                             reflect.ValueOf(unicode.Lycian),
+		"Lydian":                             reflect.ValueOf(unicode.Lydian),
+		"M":                                  reflect.ValueOf(unicode.M),
+		"Mahajani":                           reflect.ValueOf(unicode.Mahajani),
+		"Makasar":                            reflect.ValueOf(unicode.Makasar),
+		"Malayalam":                          reflect.ValueOf(unicode.Malayalam),
+		"Mandaic":                            reflect.ValueOf(unicode.Mandaic),
+		"Manichaean":                         reflect.ValueOf(unicode.Manichaean),
+		"Marchen":                            reflect.ValueOf(unicode.Marchen),
+		"Mark":                               reflect.ValueOf(unicode.Mark),
+		"Masaram_Gondi":                      reflect.ValueOf(unicode.Masaram_Gondi),
+		"MaxASCII":                           reflect.ValueOf(unicode.MaxASCII),
+		"MaxCase":                            reflect.ValueOf(unicode.MaxCase),
+		"MaxLatin1":                          reflect.ValueOf(unicode.MaxLatin1),
+		"MaxRune":                            reflect.ValueOf(unicode.MaxRune),
+		"Mc":                                 reflect.ValueOf(unicode.Mc),
+		"Me":                                 reflect.ValueOf(unicode.Me),
+		"Medefaidrin":                        reflect.ValueOf(unicode.Medefaidrin),
+		"Meetei_Mayek":                       reflect.ValueOf(unicode.Meetei_Mayek),
+		"Mende_Kikakui":                      reflect.ValueOf(unicode.Mende_Kikakui),
+		"Meroitic_Cursive":                   reflect.ValueOf(unicode.Meroitic_Cursive),
+		"Meroitic_Hieroglyphs":               reflect.ValueOf(unicode.Meroitic_Hieroglyphs),
+		"Miao":                               reflect.ValueOf(unicode.Miao),
+		"Mn":                                 reflect.ValueOf(unicode.Mn),
+		"Modi":                               reflect.ValueOf(unicode.Modi),
+		"Mongolian":                          reflect.ValueOf(unicode.Mongolian),
+		"Mro":                                reflect.ValueOf(unicode.Mro),
+		"Multani":                            reflect.ValueOf(unicode.Multani),
+		"Myanmar":                            reflect.ValueOf(unicode.Myanmar),
+		"N":                                  reflect.ValueOf(unicode.N),
+		"Nabataean":                          reflect.ValueOf(unicode.Nabataean),
+		"Nd":                                 reflect.ValueOf(unicode.Nd),
+		"New_Tai_Lue":                        reflect.ValueOf(unicode.New_Tai_Lue),
+		"Newa":                               reflect.ValueOf(unicode.Newa),
+		"Nko":                                reflect.ValueOf(unicode.Nko),
+		"Nl":                                 reflect.ValueOf(unicode.Nl),
+		"No":                                 reflect.ValueOf(unicode.No),
+		"Noncharacter_Code_Point":            reflect.ValueOf(unicode.Noncharacter_Code_Point),
+		"Number":                             reflect.ValueOf(unicode.Number),
+		"Nushu":                              reflect.ValueOf(unicode.Nushu),
+		"Ogham":                              reflect.ValueOf(unicode.Ogham),
+		"Ol_Chiki":                           reflect.ValueOf(unicode.Ol_Chiki),
+		"Old_Hungarian":                      reflect.ValueOf(unicode.Old_Hungarian),
+		"Old_Italic":                         reflect.ValueOf(unicode.Old_Italic),
+		"Old_North_Arabian":                  reflect.ValueOf(unicode.Old_North_Arabian),
+		"Old_Permic":                         reflect.ValueOf(unicode.Old_Permic),
+		"Old_Persian":                        reflect.ValueOf(unicode.Old_Persian),
+		"Old_Sogdian":                        reflect.ValueOf(unicode.Old_Sogdian),
+		"Old_South_Arabian":                  reflect.ValueOf(unicode.Old_South_Arabian),
+		"Old_Turkic":                         reflect.ValueOf(unicode.Old_Turkic),
+		"Oriya":                              reflect.ValueOf(unicode.Oriya),
+		"Osage":                              reflect.ValueOf(unicode.Osage),
+		"Osmanya":                            reflect.ValueOf(unicode.Osmanya),
+		"Other":                              reflect.ValueOf(unicode.Other),
+		"Other_Alphabetic":                   reflect.ValueOf(unicode.Other_Alphabetic),
+		"Other_Default_Ignorable_Code_Point": reflect.ValueOf(unicode.Other_Default_Ignorable_Code_Point),
+		"Other_Grapheme_Extend":              reflect.ValueOf(unicode.Other_Grapheme_Extend),
+		"Other_ID_Continue":                  reflect.ValueOf(unicode.Other_ID_Continue),
+		"Other_ID_Start":                     reflect.ValueOf(unicode.Other_ID_Start),
+		"Other_Lowercase":                    reflect.ValueOf(unicode.Other_Lowercase),
+		"Other_Math":                         reflect.ValueOf(unicode.Other_Math),
+		"Other_Uppercase":                    reflect.ValueOf(unicode.Other_Uppercase),
+		"P":                                  reflect.ValueOf(unicode.P),
+		"Pahawh_Hmong":                       reflect.ValueOf(unicode.Pahawh_Hmong),
+		"Palmyrene":                          reflect.ValueOf(unicode.Palmyrene),
+		"Pattern_Syntax":                     reflect.ValueOf(unicode.Pattern_Syntax),
+		"Pattern_White_Space":                reflect.ValueOf(unicode.Pattern_White_Space),
+		"Pau_Cin_Hau":                        reflect.ValueOf(unicode.Pau_Cin_Hau),
+		"Pc":                                 reflect.ValueOf(unicode.Pc),
+		"Pd":                                 reflect.ValueOf(unicode.Pd),
+		"Pe":                                 reflect.ValueOf(unicode.Pe),
+		"Pf":                                 reflect.ValueOf(unicode.Pf),
+		"Phags_Pa":                           reflect.ValueOf(unicode.Phags_Pa),
+		"Phoenician":                         reflect.ValueOf(unicode.Phoenician),
+		"Pi":                                 reflect.ValueOf(unicode.Pi),
+		"Po":                                 reflect.ValueOf(unicode.Po),
+		"Prepended_Concatenation_Mark":       reflect.ValueOf(unicode.Prepended_Concatenation_Mark),
+		"PrintRanges":                        reflect.ValueOf(unicode.PrintRanges),
+		"Properties":                         reflect.ValueOf(unicode.Properties),
+		"Ps":                                 reflect.ValueOf(unicode.Ps),
+		"Psalter_Pahlavi":                    reflect.ValueOf(unicode.Psalter_Pahlavi),
+		"Punct":                              reflect.ValueOf(unicode.Punct),
+		"Quotation_Mark":                     reflect.ValueOf(unicode.Quotation_Mark),
+		"Radical":                            reflect.ValueOf(unicode.Radical),
+		"Regional_Indicator":                 reflect.ValueOf(unicode.Regional_Indicator),
+		"Rejang":                             reflect.ValueOf(unicode.Rejang),
+		"ReplacementChar":                    reflect.ValueOf(unicode.ReplacementChar),
+		"Runic":                              reflect.ValueOf(unicode.Runic),
+		"S":                                  reflect.ValueOf(unicode.S),
+		"STerm":                              reflect.ValueOf(unicode.STerm),
+		"Samaritan":                          reflect.ValueOf(unicode.Samaritan),
+		"Saurashtra":                         reflect.ValueOf(unicode.Saurashtra),
+		"Sc":                                 reflect.ValueOf(unicode.Sc),
+		"Scripts":                            reflect.ValueOf(unicode.Scripts),
+		"Sentence_Terminal":                  reflect.ValueOf(unicode.Sentence_Terminal),
+		"Sharada":                            reflect.ValueOf(unicode.Sharada),
+		"Shavian":                            reflect.ValueOf(unicode.Shavian),
+		"Siddham":                            reflect.ValueOf(unicode.Siddham),
+		"SignWriting":                        reflect.ValueOf(unicode.SignWriting),
+		"SimpleFold":                         reflect.ValueOf(unicode.SimpleFold),
+		"Sinhala":                            reflect.ValueOf(unicode.Sinhala),
+		"Sk":                                 reflect.ValueOf(unicode.Sk),
+		"Sm":                                 reflect.ValueOf(unicode.Sm),
+		"So":                                 reflect.ValueOf(unicode.So),
+		"Soft_Dotted":                        reflect.ValueOf(unicode.Soft_Dotted),
+		"Sogdian":                            reflect.ValueOf(unicode.Sogdian),
+		"Sora_Sompeng":                       reflect.ValueOf(unicode.Sora_Sompeng),
+		"Soyombo":                            reflect.ValueOf(unicode.Soyombo),
+		"Space":                              reflect.ValueOf(unicode.Space),
+		"Sundanese":                          reflect.ValueOf(unicode.Sundanese),
+		"Syloti_Nagri":                       reflect.ValueOf(unicode.Syloti_Nagri),
+		"Symbol":                             reflect.ValueOf(unicode.Symbol),
+		"Syriac":                             reflect.ValueOf(unicode.Syriac),
+		"Tagalog":                            reflect.ValueOf(unicode.Tagalog),
+		"Tagbanwa":                           reflect.ValueOf(unicode.Tagbanwa),
+		"Tai_Le":                             reflect.ValueOf(unicode.Tai_Le),
+		"Tai_Tham":                           reflect.ValueOf(unicode.Tai_Tham),
+		"Tai_Viet":                           reflect.ValueOf(unicode.Tai_Viet),
+		"Takri":                              reflect.ValueOf(unicode.Takri),
+		"Tamil":                              reflect.ValueOf(unicode.Tamil),
+		"Tangut":                             reflect.ValueOf(unicode.Tangut),
+		"Telugu":                             reflect.ValueOf(unicode.Telugu),
+		"Terminal_Punctuation":               reflect.ValueOf(unicode.Terminal_Punctuation),
+		"Thaana":                             reflect.ValueOf(unicode.Thaana),
+		"Thai":                               reflect.ValueOf(unicode.Thai),
+		"Tibetan":                            reflect.ValueOf(unicode.Tibetan),
+		"Tifinagh":                           reflect.ValueOf(unicode.Tifinagh),
+		"Tirhuta":                            reflect.ValueOf(unicode.Tirhuta),
+		"Title":                              reflect.ValueOf(unicode.Title),
+		"TitleCase":                          reflect.ValueOf(unicode.TitleCase),
+		"To":                                 reflect.ValueOf(unicode.To),
+		"ToLower":                            reflect.ValueOf(unicode.ToLower),
+		"ToTitle":                            reflect.ValueOf(unicode.ToTitle),
+		"ToUpper":                            reflect.ValueOf(unicode.ToUpper),
+		"TurkishCase":                        reflect.ValueOf(unicode.TurkishCase),
+		"Ugaritic":                           reflect.ValueOf(unicode.Ugaritic),
+		"Unified_Ideograph":                  reflect.ValueOf(unicode.Unified_Ideograph),
+		"Upper":                              reflect.ValueOf(unicode.Upper),
+		"UpperCase":                          reflect.ValueOf(unicode.UpperCase),
+		"UpperLower":                         reflect.ValueOf(unicode.UpperLower),
+		"Vai":                                reflect.ValueOf(unicode.Vai),
+		"Variation_Selector":                 reflect.ValueOf(unicode.Variation_Selector),
+		"Version":                            reflect.ValueOf(unicode.Version),
+		"Warang_Citi":                        reflect.ValueOf(unicode.Warang_Citi),
+		"White_Space":                        reflect.ValueOf(unicode.White_Space),
+		"Yi":                                 reflect.ValueOf(unicode.Yi),
+		"Z":                                  reflect.ValueOf(unicode.Z),
+		"Zanabazar_Square":                   reflect.ValueOf(unicode.Zanabazar_Square),
+		"Zl":                                 reflect.ValueOf(unicode.Zl),
+		"Zp":                                 reflect.ValueOf(unicode.Zp),
+		"Zs":                                 reflect.ValueOf(unicode.Zs),
+	}
+	env.PackageTypes["unicode"] = map[string]reflect.Type{
+		"CaseRange":   reflect.TypeOf(unicode.CaseRange{}),
+		"Range16":     reflect.TypeOf(unicode.Range16{}),
+		"Range32":     reflect.TypeOf(unicode.Range32{}),
+		"RangeTable":  reflect.TypeOf(unicode.RangeTable{}),
+		"SpecialCase": reflect.TypeOf(unicode.SpecialCase{}),
+	}
+	unicodeGo114()
+	unicodeGo116()
+	unicodeGo121()
+	unicodeGo125()
+	unicodeGo127()
+}
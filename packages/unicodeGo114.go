@@ -0,0 +1,20 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.14
+// +build go1.14
+
+package packages
+
+import (
+	"reflect"
+	"unicode"
+
+	"github.com/mattn/anko/env"
+)
+
+func unicodeGo114() {
+	env.Packages["unicode"]["Elymaic"] = reflect.ValueOf(unicode.Elymaic)
+	env.Packages["unicode"]["Nandinagari"] = reflect.ValueOf(unicode.Nandinagari)
+	env.Packages["unicode"]["Nyiakeng_Puachue_Hmong"] = reflect.ValueOf(unicode.Nyiakeng_Puachue_Hmong)
+	env.Packages["unicode"]["Wancho"] = reflect.ValueOf(unicode.Wancho)
+}
@@ -0,0 +1,20 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.16
+// +build go1.16
+
+package packages
+
+import (
+	"reflect"
+	"unicode"
+
+	"github.com/mattn/anko/env"
+)
+
+func unicodeGo116() {
+	env.Packages["unicode"]["Chorasmian"] = reflect.ValueOf(unicode.Chorasmian)
+	env.Packages["unicode"]["Dives_Akuru"] = reflect.ValueOf(unicode.Dives_Akuru)
+	env.Packages["unicode"]["Khitan_Small_Script"] = reflect.ValueOf(unicode.Khitan_Small_Script)
+	env.Packages["unicode"]["Yezidi"] = reflect.ValueOf(unicode.Yezidi)
+}
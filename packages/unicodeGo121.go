@@ -0,0 +1,23 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.21
+// +build go1.21
+
+package packages
+
+import (
+	"reflect"
+	"unicode"
+
+	"github.com/mattn/anko/env"
+)
+
+func unicodeGo121() {
+	env.Packages["unicode"]["Cypro_Minoan"] = reflect.ValueOf(unicode.Cypro_Minoan)
+	env.Packages["unicode"]["Kawi"] = reflect.ValueOf(unicode.Kawi)
+	env.Packages["unicode"]["Nag_Mundari"] = reflect.ValueOf(unicode.Nag_Mundari)
+	env.Packages["unicode"]["Old_Uyghur"] = reflect.ValueOf(unicode.Old_Uyghur)
+	env.Packages["unicode"]["Tangsa"] = reflect.ValueOf(unicode.Tangsa)
+	env.Packages["unicode"]["Toto"] = reflect.ValueOf(unicode.Toto)
+	env.Packages["unicode"]["Vithkuqi"] = reflect.ValueOf(unicode.Vithkuqi)
+}
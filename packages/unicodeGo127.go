@@ -0,0 +1,31 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build go1.27
+// +build go1.27
+
+package packages
+
+import (
+	"reflect"
+	"unicode"
+
+	"github.com/mattn/anko/env"
+)
+
+func unicodeGo127() {
+	env.Packages["unicode"]["Beria_Erfe"] = reflect.ValueOf(unicode.Beria_Erfe)
+	env.Packages["unicode"]["Garay"] = reflect.ValueOf(unicode.Garay)
+	env.Packages["unicode"]["Gurung_Khema"] = reflect.ValueOf(unicode.Gurung_Khema)
+	env.Packages["unicode"]["IDS_Unary_Operator"] = reflect.ValueOf(unicode.IDS_Unary_Operator)
+	env.Packages["unicode"]["ID_Compat_Math_Continue"] = reflect.ValueOf(unicode.ID_Compat_Math_Continue)
+	env.Packages["unicode"]["ID_Compat_Math_Start"] = reflect.ValueOf(unicode.ID_Compat_Math_Start)
+	env.Packages["unicode"]["Kirat_Rai"] = reflect.ValueOf(unicode.Kirat_Rai)
+	env.Packages["unicode"]["Modifier_Combining_Mark"] = reflect.ValueOf(unicode.Modifier_Combining_Mark)
+	env.Packages["unicode"]["Ol_Onal"] = reflect.ValueOf(unicode.Ol_Onal)
+	env.Packages["unicode"]["Sidetic"] = reflect.ValueOf(unicode.Sidetic)
+	env.Packages["unicode"]["Sunuwar"] = reflect.ValueOf(unicode.Sunuwar)
+	env.Packages["unicode"]["Tai_Yo"] = reflect.ValueOf(unicode.Tai_Yo)
+	env.Packages["unicode"]["Todhri"] = reflect.ValueOf(unicode.Todhri)
+	env.Packages["unicode"]["Tolong_Siki"] = reflect.ValueOf(unicode.Tolong_Siki)
+	env.Packages["unicode"]["Tulu_Tigalari"] = reflect.ValueOf(unicode.Tulu_Tigalari)
+}
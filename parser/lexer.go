@@ -45,6 +45,7 @@ var opName = map[string]int{
 	"func":     FUNC,
 	"return":   RETURN,
 	"var":      VAR,
+	"const":    CONST,
 	"throw":    THROW,
 	"if":       IF,
 	"for":      FOR,
@@ -80,6 +81,9 @@ var (
 	trueValue  = reflect.ValueOf(true)
 	falseValue = reflect.ValueOf(false)
 	oneLiteral = &ast.LiteralExpr{Literal: reflect.ValueOf(int64(1))}
+
+	// errUnexpectedEOF is the error of a string, raw string or block comment that is not terminated
+	errUnexpectedEOF = errors.New("unexpected EOF")
 )
 
 // Init resets code to scan.
@@ -504,7 +508,7 @@ func (s *Scanner) scanRawString(l rune) (string, error) {
 	for {
 		s.next()
 		if s.peek() == EOF {
-			return "", errors.New("unexpected EOF")
+			return "", errUnexpectedEOF
 		}
 		if s.peek() == l {
 			s.next()
@@ -526,7 +530,7 @@ eos:
 		case EOL:
 			return "", errors.New("unexpected EOL")
 		case EOF:
-			return "", errors.New("unexpected EOF")
+			return "", errUnexpectedEOF
 		case l:
 			s.next()
 			break eos
@@ -565,11 +569,19 @@ type Lexer struct {
 	pos  ast.Position
 	e    error
 	stmt ast.Stmt
+	tok  int
 }
 
 // Lex scans the token and literals.
+// The new lines after a binary operator or a comma are skipped, so an expression can continue on the next line.
 func (l *Lexer) Lex(lval *yySymType) int {
 	tok, lit, pos, err := l.s.Scan()
+	if _, continued := continuedTokens[l.tok]; continued {
+		for tok == '\n' && err == nil {
+			tok, lit, pos, err = l.s.Scan()
+		}
+	}
+	l.tok = tok
 	if err != nil {
 		l.e = &Error{Message: err.Error(), Pos: pos, Fatal: true}
 	}
@@ -612,6 +624,49 @@ func ParseSrc(src string) (ast.Stmt, error) {
 	return Parse(scanner)
 }
 
+// continuedTokens are the binary operators and the comma that need more input when they end the source
+var continuedTokens = map[int]struct{}{
+	'+': {}, '-': {}, '*': {}, '/': {}, '%': {}, '<': {}, '>': {}, '&': {}, '|': {}, '^': {}, '=': {}, ',': {},
+	EQEQ: {}, NEQ: {}, GE: {}, LE: {}, OROR: {}, ANDAND: {}, NILCOALESCE: {}, SHIFTLEFT: {}, SHIFTRIGHT: {},
+	PLUSEQ: {}, MINUSEQ: {}, MULEQ: {}, DIVEQ: {}, ANDEQ: {}, OREQ: {}, OPCHAN: {}, EQOPCHAN: {},
+}
+
+// IsIncomplete returns true if src needs more input to be complete,
+// that is when there are unclosed brackets, an unterminated raw string or block comment,
+// or when it ends with a binary operator or a comma.
+// Interactive shells can use it to decide when to read more lines.
+func IsIncomplete(src string) bool {
+	scanner := &Scanner{
+		src: []rune(src),
+	}
+	depth := 0
+	last := 0
+	for {
+		scanner.skipBlank()
+		start := scanner.peek()
+		tok, _, _, err := scanner.Scan()
+		if err != nil {
+			// raw strings and block comments are the only tokens that can span lines
+			return err == errUnexpectedEOF && (start == '`' || start == '/')
+		}
+		switch tok {
+		case EOF:
+			_, continued := continuedTokens[last]
+			return depth > 0 || continued
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+		if _, continued := continuedTokens[last]; tok != '\n' || !continued {
+			last = tok
+		}
+	}
+}
+
 func toNumber(numString string) (reflect.Value, error) {
 	// hex
 	if len(numString) > 2 && numString[0:2] == "0x" {
@@ -0,0 +1,281 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/ast/astutil"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+)
+
+// command is a REPL command, entered as :name followed by the argument
+type command struct {
+	name  string
+	usage string
+	help  string
+	run   func(repl *REPL, ctx context.Context, argument string) error
+}
+
+// errQuit is returned by the quit command to end the REPL
+var errQuit = errors.New("quit")
+
+// commands are the REPL commands, sorted by name
+var commands []command
+
+func init() {
+	commands = []command{
+		{name: "ast", usage: "expr", help: "print the parsed syntax tree of expr without running it", run: commandAST},
+		{name: "doc", usage: "name", help: "print the Go type of a package member, package or symbol, like strings.Split", run: commandDoc},
+		{name: "env", help: "list the symbols and types of the env", run: commandEnv},
+		{name: "help", help: "list the commands", run: commandHelp},
+		{name: "load", usage: "file", help: "run the script file in the env", run: commandLoad},
+		{name: "packages", help: "list the packages that can be imported", run: commandPackages},
+		{name: "quit", help: "exit the REPL", run: commandQuit},
+		{name: "reset", help: "replace the env with a new env", run: commandReset},
+		{name: "time", usage: "expr", help: "run expr and print how long it took", run: commandTime},
+		{name: "type", usage: "expr", help: "run expr and print the Go type of the result", run: commandType},
+	}
+}
+
+// runCommand runs the command line, which starts with a colon
+func (repl *REPL) runCommand(ctx context.Context, line string) error {
+	name := strings.TrimPrefix(line, ":")
+	var argument string
+	if index := strings.IndexAny(name, " \t"); index >= 0 {
+		name, argument = name[:index], strings.TrimSpace(name[index+1:])
+	}
+
+	for _, command := range commands {
+		if command.name != name {
+			continue
+		}
+		if command.usage != "" && argument == "" {
+			return fmt.Errorf("usage: :%v %v", command.name, command.usage)
+		}
+		if command.usage == "" && argument != "" {
+			return fmt.Errorf("usage: :%v", command.name)
+		}
+		return command.run(repl, ctx, argument)
+	}
+
+	return fmt.Errorf("unknown command ':%v', enter :help for the commands", name)
+}
+
+func commandHelp(repl *REPL, ctx context.Context, argument string) error {
+	for _, command := range commands {
+		usage := ":" + command.name
+		if command.usage != "" {
+			usage += " " + command.usage
+		}
+		fmt.Fprintf(repl.out, "%-16v %v\n", usage, command.help)
+	}
+	return nil
+}
+
+func commandQuit(repl *REPL, ctx context.Context, argument string) error {
+	return errQuit
+}
+
+// commandEnv lists the values and types in all scopes, skipping the symbols hidden by an inner scope
+func commandEnv(repl *REPL, ctx context.Context, argument string) error {
+	found := make(map[string]struct{})
+	repl.Env.Walk(func(scopeDepth int, symbol string, value reflect.Value) bool {
+		if _, ok := found[symbol]; ok {
+			return true
+		}
+		found[symbol] = struct{}{}
+		fmt.Fprintln(repl.out, docValue(symbol, value))
+		return true
+	})
+
+	found = make(map[string]struct{})
+	for e := repl.Env; e != nil; e = e.Parent() {
+		for _, symbol := range e.Types() {
+			if _, ok := found[symbol]; ok {
+				continue
+			}
+			found[symbol] = struct{}{}
+			t, err := e.Type(symbol)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(repl.out, "type %v = %v\n", symbol, t)
+		}
+	}
+	return nil
+}
+
+func commandType(repl *REPL, ctx context.Context, argument string) error {
+	value, err := repl.run(ctx, argument)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(repl.out, typeString(reflect.ValueOf(value)))
+	return nil
+}
+
+func commandTime(repl *REPL, ctx context.Context, argument string) error {
+	start := time.Now()
+	value, err := repl.run(ctx, argument)
+	elapsed := time.Since(start)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(repl.out, Format(value))
+	fmt.Fprintf(repl.out, "time: %v\n", elapsed)
+	return nil
+}
+
+// commandAST prints the syntax tree of each statement
+func commandAST(repl *REPL, ctx context.Context, argument string) error {
+	stmt, err := parser.ParseSrc(argument)
+	if err != nil {
+		return err
+	}
+	if stmts, ok := stmt.(*ast.StmtsStmt); ok {
+		for _, stmt := range stmts.Stmts {
+			err = astutil.Fprint(repl.out, stmt)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return astutil.Fprint(repl.out, stmt)
+}
+
+func commandLoad(repl *REPL, ctx context.Context, argument string) error {
+	source, err := ioutil.ReadFile(argument)
+	if err != nil {
+		return err
+	}
+	value, err := repl.run(ctx, string(source))
+	if err != nil {
+		return fmt.Errorf("%v:%v", argument, formatError(err))
+	}
+	fmt.Fprintln(repl.out, Format(value))
+	return nil
+}
+
+func commandReset(repl *REPL, ctx context.Context, argument string) error {
+	repl.Env = env.NewEnv()
+	if repl.EnvSetupFunc != nil {
+		repl.EnvSetupFunc(repl.Env)
+	}
+	return nil
+}
+
+func commandPackages(repl *REPL, ctx context.Context, argument string) error {
+	for _, name := range packageNames() {
+		fmt.Fprintln(repl.out, name)
+	}
+	return nil
+}
+
+// commandDoc prints the Go types of a package member, all the members of a package or an env symbol or member
+func commandDoc(repl *REPL, ctx context.Context, argument string) error {
+	if _, ok := env.Packages[argument]; ok {
+		return repl.docPackage(argument)
+	}
+	if _, ok := env.PackageTypes[argument]; ok {
+		return repl.docPackage(argument)
+	}
+
+	if dot := strings.LastIndex(argument, "."); dot > 0 {
+		packageName, name := argument[:dot], argument[dot+1:]
+		if value, ok := env.Packages[packageName][name]; ok {
+			fmt.Fprintln(repl.out, docValue(argument, value))
+			return nil
+		}
+		if t, ok := env.PackageTypes[packageName][name]; ok {
+			fmt.Fprintf(repl.out, "type %v = %v\n", argument, t)
+			return nil
+		}
+	}
+
+	value, ok := repl.lookupPath(strings.Split(argument, "."))
+	if !ok {
+		return fmt.Errorf("no package or symbol named '%v'", argument)
+	}
+	fmt.Fprintln(repl.out, docValue(argument, value))
+	return nil
+}
+
+// docPackage prints the values and types of the package, sorted by name
+func (repl *REPL) docPackage(packageName string) error {
+	values := env.Packages[packageName]
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintln(repl.out, docValue(packageName+"."+name, values[name]))
+	}
+
+	types := env.PackageTypes[packageName]
+	names = names[:0]
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(repl.out, "type %v.%v = %v\n", packageName, name, types[name])
+	}
+	return nil
+}
+
+// docValue returns the Go signature of a func or the type and value of other values
+func docValue(name string, value reflect.Value) string {
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() == reflect.Func && !isScriptFunc(value.Type()) {
+		return "func " + name + strings.TrimPrefix(value.Type().String(), "func")
+	}
+	return fmt.Sprintf("%v %v = %v", name, typeString(value), Format(valueInterface(value)))
+}
+
+// packageNames returns the sorted names of the packages with values or types
+func packageNames() []string {
+	names := make([]string, 0, len(env.Packages))
+	for name := range env.Packages {
+		names = append(names, name)
+	}
+	for name := range env.PackageTypes {
+		if _, ok := env.Packages[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// typeString returns the type of the value, the type of the element for interface values
+func typeString(value reflect.Value) string {
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() || (value.Kind() == reflect.Interface && value.IsNil()) {
+		return "nil"
+	}
+	if value.Kind() == reflect.Func && isScriptFunc(value.Type()) {
+		return "func"
+	}
+	return value.Type().String()
+}
+
+func valueInterface(value reflect.Value) interface{} {
+	if !value.IsValid() || !value.CanInterface() {
+		return nil
+	}
+	return value.Interface()
+}
@@ -0,0 +1,150 @@
+package repl
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mattn/anko/env"
+)
+
+func TestCommands(t *testing.T) {
+	dir, err := ioutil.TempDir("", "anko-repl")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(dir)
+	loadFile := filepath.Join(dir, "load.ank")
+	err = ioutil.WriteFile(loadFile, []byte("b = 2\nc = a + b\n"), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+	brokenFile := filepath.Join(dir, "broken.ank")
+	err = ioutil.WriteFile(brokenFile, []byte("b = 2\nc = d\n"), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+
+	packages, packageTypes := env.Packages, env.PackageTypes
+	env.Packages = map[string]map[string]reflect.Value{
+		"strings": {"Split": reflect.ValueOf(strings.Split), "Count": reflect.ValueOf(1)},
+		"os":      {},
+	}
+	env.PackageTypes = map[string]map[string]reflect.Type{
+		"strings": {"Builder": reflect.TypeOf(strings.Builder{})},
+		"io":      {},
+	}
+	defer func() { env.Packages, env.PackageTypes = packages, packageTypes }()
+
+	tests := []struct {
+		input     string
+		outputs   []string
+		errOutput string
+	}{
+		{input: ":type a", outputs: []string{"int"}},
+		{input: ":type nil", outputs: []string{"nil"}},
+		{input: ":type func() {}", outputs: []string{"func"}},
+		{input: ":type  [1]", outputs: []string{"[]interface {}"}},
+		{input: ":type b", errOutput: "1:1 undefined symbol 'b'"},
+		{input: ":type", errOutput: "usage: :type expr"},
+		{input: ":env", outputs: []string{"a int = 1", "func f(int) int", "type myInt = int"}},
+		{input: "b = 2\n:env", outputs: []string{"2", "a int = 1", "b int64 = 2", "func f(int) int", "type myInt = int"}},
+		{input: ":env a", errOutput: "usage: :env"},
+		{input: ":load " + loadFile + "\nc", outputs: []string{"3", "3"}},
+		{input: ":load " + brokenFile + "\nb", outputs: []string{"2"}, errOutput: brokenFile + ":2:5 undefined symbol 'd'"},
+		{input: ":load " + filepath.Join(dir, "missing.ank"), errOutput: "open " + filepath.Join(dir, "missing.ank") + ": no such file or directory"},
+		{input: ":reset\na", outputs: []string{`"reset"`}},
+		{input: ":time a + 1", outputs: []string{"2", "time: "}},
+		{input: ":time b", errOutput: "1:1 undefined symbol 'b'"},
+		{input: ":ast a", outputs: []string{"*ast.ExprStmt 1:1 {", "\tExpr: *ast.IdentExpr 1:1 {", "\t\tLit: \"a\"", "\t}", "}"}},
+		{input: ":ast b; 1", outputs: []string{"*ast.ExprStmt 1:1 {", "\tExpr: *ast.IdentExpr 1:1 {", "\t\tLit: \"b\"", "\t}", "}",
+			"*ast.ExprStmt 1:4 {", "\tExpr: *ast.LiteralExpr 1:4 {", "\t\tLiteral: 1", "\t}", "}"}},
+		{input: ":ast ..", errOutput: "1:1 syntax error on '.' at 1:1"},
+		{input: ":packages", outputs: []string{"io", "os", "strings"}},
+		{input: ":doc strings.Split", outputs: []string{"func strings.Split(string, string) []string"}},
+		{input: ":doc strings.Builder", outputs: []string{"type strings.Builder = strings.Builder"}},
+		{input: ":doc strings", outputs: []string{"strings.Count int = 1", "func strings.Split(string, string) []string", "type strings.Builder = strings.Builder"}},
+		{input: ":doc f", outputs: []string{"func f(int) int"}},
+		{input: ":doc a", outputs: []string{"a int = 1"}},
+		{input: ":doc strings.Foo", errOutput: "no package or symbol named 'strings.Foo'"},
+		{input: ":quit\na", outputs: nil},
+		{input: ":foo", errOutput: "unknown command ':foo', enter :help for the commands"},
+	}
+
+	for _, test := range tests {
+		e := env.NewEnv()
+		e.Define("a", 1)
+		e.Define("f", func(i int) int { return i })
+		e.DefineType("myInt", 1)
+		var out, errOut strings.Builder
+		repl := New(e, strings.NewReader(test.input+"\n"), &out, &errOut)
+		repl.Prompt = ""
+		repl.EnvSetupFunc = func(e *env.Env) {
+			e.Define("a", "reset")
+		}
+		err := repl.Run(context.Background())
+		if err != nil {
+			t.Fatalf("Run error: %v - input: %v", err, test.input)
+		}
+
+		var outputs []string
+		for _, line := range strings.Split(out.String(), "\n") {
+			if strings.HasPrefix(line, "time: ") {
+				line = "time: "
+			}
+			if line != "" {
+				outputs = append(outputs, line)
+			}
+		}
+		if !reflect.DeepEqual(outputs, test.outputs) {
+			t.Errorf("output - received: %q - expected: %q - input: %v", outputs, test.outputs, test.input)
+		}
+		if strings.TrimSpace(errOut.String()) != test.errOutput {
+			t.Errorf("error output - received: %v - expected: %v - input: %v", errOut.String(), test.errOutput, test.input)
+		}
+	}
+}
+
+func TestCommandHelp(t *testing.T) {
+	_, outputs, _ := runREPL(t, env.NewEnv(), ":help\n", false, "")
+	for _, command := range commands {
+		if !strings.Contains(outputs, ":"+command.name) {
+			t.Errorf("help output does not contain :%v - output: %v", command.name, outputs)
+		}
+	}
+}
+
+func TestCompleteCommand(t *testing.T) {
+	packages := env.Packages
+	env.Packages = map[string]map[string]reflect.Value{"strings": {"Split": reflect.ValueOf(strings.Split)}, "sort": {}}
+	defer func() { env.Packages = packages }()
+
+	e := env.NewEnv()
+	e.Define("sum", 1)
+
+	tests := []struct {
+		line       string
+		candidates []string
+		start      int
+	}{
+		{line: ":", candidates: []string{"ast", "doc", "env", "help", "load", "packages", "quit", "reset", "time", "type"}, start: 1},
+		{line: ":t", candidates: []string{"time", "type"}, start: 1},
+		{line: " :re", candidates: []string{"reset"}, start: 2},
+		{line: ":doc s", candidates: []string{"sort", "strings", "struct", "sum", "switch"}, start: 5},
+		{line: ":doc strings.Sp", candidates: []string{"strings.Split"}, start: 5},
+		{line: ":type su", candidates: []string{"sum"}, start: 6},
+	}
+
+	repl := New(e, strings.NewReader(""), ioutil.Discard, ioutil.Discard)
+	for _, test := range tests {
+		line := []rune(test.line)
+		candidates, start := repl.complete(line, len(line))
+		if !reflect.DeepEqual(candidates, test.candidates) || start != test.start {
+			t.Errorf("complete - received: %q, %v - expected: %q, %v - line: %q", candidates, start, test.candidates, test.start, test.line)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package repl
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/mattn/anko/env"
+)
+
+// keywords are the anko keywords completed with env symbols
+var keywords = []string{
+	"break", "case", "catch", "chan", "close", "const", "continue", "default", "delete", "else", "false", "finally",
+	"for", "func", "go", "if", "import", "in", "len", "make", "map", "module", "new", "nil", "return", "struct",
+	"switch", "throw", "true", "try", "type", "var",
+}
+
+// complete returns the completion candidates for the word ending at pos in line and the start of the word.
+// A word is completed from the env symbols, keywords, members of a value or module, or package names in import.
+func (repl *REPL) complete(line []rune, pos int) ([]string, int) {
+	if candidates, start, ok := repl.completeCommand(line, pos); ok {
+		return candidates, start
+	}
+	if candidates, start, ok := completePackages(line, pos); ok {
+		return candidates, start
+	}
+
+	start := pos
+	for start > 0 && (isIdentRune(line[start-1]) || line[start-1] == '.') {
+		start--
+	}
+	word := string(line[start:pos])
+
+	dot := strings.LastIndex(word, ".")
+	if dot < 0 {
+		return filterPrefix(repl.symbols(), word), start
+	}
+
+	value, ok := repl.lookupPath(strings.Split(word[:dot], "."))
+	if !ok {
+		return nil, start
+	}
+	return filterPrefix(members(value), word[dot+1:]), start + len([]rune(word[:dot])) + 1
+}
+
+// completeCommand returns the command names if pos is in the command name,
+// or the package names, package members and symbols if pos is in the argument of :doc
+func (repl *REPL) completeCommand(line []rune, pos int) ([]string, int, bool) {
+	text := string(line[:pos])
+	trimmed := strings.TrimLeft(text, " ")
+	if !strings.HasPrefix(trimmed, ":") {
+		return nil, 0, false
+	}
+	start := len([]rune(text)) - len([]rune(trimmed)) + 1
+
+	if !strings.ContainsAny(trimmed, " \t") {
+		names := make([]string, len(commands))
+		for i, command := range commands {
+			names[i] = command.name
+		}
+		return filterPrefix(names, trimmed[1:]), start, true
+	}
+
+	if !strings.HasPrefix(trimmed, ":doc ") {
+		return nil, 0, false
+	}
+	start = pos
+	for start > 0 && (isIdentRune(line[start-1]) || line[start-1] == '/' || line[start-1] == '.') {
+		start--
+	}
+	word := string(line[start:pos])
+	if dot := strings.LastIndex(word, "."); dot > 0 {
+		packageName := word[:dot]
+		var names []string
+		for name := range env.Packages[packageName] {
+			names = append(names, packageName+"."+name)
+		}
+		for name := range env.PackageTypes[packageName] {
+			names = append(names, packageName+"."+name)
+		}
+		if len(names) > 0 {
+			return filterPrefix(names, word), start, true
+		}
+		// not a package, complete the members of env symbols
+		return nil, 0, false
+	}
+	return filterPrefix(append(packageNames(), repl.symbols()...), word), start, true
+}
+
+// completePackages returns the package names if pos is in the string argument of import
+func completePackages(line []rune, pos int) ([]string, int, bool) {
+	start := pos
+	for start > 0 && (isIdentRune(line[start-1]) || line[start-1] == '/' || line[start-1] == '.') {
+		start--
+	}
+	if start == 0 || (line[start-1] != '"' && line[start-1] != '\'') {
+		return nil, 0, false
+	}
+	if !strings.HasSuffix(strings.TrimRight(string(line[:start-1]), " "), "import(") {
+		return nil, 0, false
+	}
+
+	names := make([]string, 0, len(env.Packages))
+	for name := range env.Packages {
+		names = append(names, name)
+	}
+	return filterPrefix(names, string(line[start:pos])), start, true
+}
+
+// symbols returns the env symbols in all scopes and the keywords
+func (repl *REPL) symbols() []string {
+	symbols := append([]string{}, keywords...)
+	repl.Env.Walk(func(scopeDepth int, symbol string, value reflect.Value) bool {
+		symbols = append(symbols, symbol)
+		return true
+	})
+	return symbols
+}
+
+// lookupPath returns the value of the dot separated path of symbol and members, without running any script
+func (repl *REPL) lookupPath(path []string) (reflect.Value, bool) {
+	value, err := repl.Env.GetValue(path[0])
+	if err != nil {
+		return reflect.Value{}, false
+	}
+
+	for _, name := range path[1:] {
+		value = indirect(value)
+		if !value.IsValid() {
+			return reflect.Value{}, false
+		}
+		if e, ok := value.Interface().(*env.Env); ok {
+			value, err = e.GetValue(name)
+			if err != nil {
+				return reflect.Value{}, false
+			}
+			continue
+		}
+
+		switch value.Kind() {
+		case reflect.Struct:
+			value = value.FieldByName(name)
+		case reflect.Map:
+			if value.Type().Key().Kind() != reflect.String && value.Type().Key().Kind() != reflect.Interface {
+				return reflect.Value{}, false
+			}
+			value = value.MapIndex(reflect.ValueOf(name).Convert(value.Type().Key()))
+		default:
+			return reflect.Value{}, false
+		}
+		if !value.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+
+	return value, true
+}
+
+// members returns the member names of value: symbols of a module, keys of a map or fields and methods
+func members(value reflect.Value) []string {
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return nil
+	}
+	if e, ok := value.Interface().(*env.Env); ok {
+		return append(e.Symbols(), e.Types()...)
+	}
+
+	var names []string
+	for i := 0; i < value.NumMethod(); i++ {
+		names = append(names, value.Type().Method(i).Name)
+	}
+
+	value = indirect(value)
+	switch value.Kind() {
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath == "" {
+				names = append(names, field.Name)
+			}
+		}
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			if key.Kind() == reflect.Interface {
+				key = key.Elem()
+			}
+			if key.Kind() == reflect.String {
+				names = append(names, key.String())
+			}
+		}
+	}
+	return names
+}
+
+// indirect returns the value pointed to by pointers and interfaces
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// filterPrefix returns the sorted unique names starting with prefix
+func filterPrefix(names []string, prefix string) []string {
+	found := make(map[string]struct{})
+	var filtered []string
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if _, ok := found[name]; ok {
+			continue
+		}
+		found[name] = struct{}{}
+		filtered = append(filtered, name)
+	}
+	sort.Strings(filtered)
+	return filtered
+}
+
+func isIdentRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
@@ -0,0 +1,291 @@
+package repl
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// completeFunc returns the completion candidates for the word ending at pos in line and the start of the word
+type completeFunc func(line []rune, pos int) (candidates []string, start int)
+
+// editor is a line editor for terminals in raw mode, using ANSI escape sequences
+type editor struct {
+	in       *bufio.Reader
+	out      io.Writer
+	prompt   string
+	history  []string
+	complete completeFunc
+
+	line []rune
+	pos  int
+	// historyIndex is the history entry being edited, len(history) for the new line
+	historyIndex int
+	// edits are the edited history entries and new line, by history index
+	edits map[int][]rune
+}
+
+// newlineSymbol is shown for newlines in the line, it must be a single column wide
+const newlineSymbol = "↵"
+
+// key codes
+const (
+	keyCtrlA     = 1
+	keyCtrlB     = 2
+	keyCtrlC     = 3
+	keyCtrlD     = 4
+	keyCtrlE     = 5
+	keyCtrlF     = 6
+	keyCtrlH     = 8
+	keyTab       = 9
+	keyLF        = 10
+	keyCtrlK     = 11
+	keyCtrlL     = 12
+	keyCR        = 13
+	keyCtrlN     = 14
+	keyCtrlP     = 16
+	keyCtrlU     = 21
+	keyCtrlW     = 23
+	keyEscape    = 27
+	keyBackspace = 127
+)
+
+// newEditor returns a new line editor
+func newEditor(in *bufio.Reader, out io.Writer, prompt string, history []string, complete completeFunc) *editor {
+	return &editor{
+		in:           in,
+		out:          out,
+		prompt:       prompt,
+		history:      history,
+		complete:     complete,
+		historyIndex: len(history),
+		edits:        make(map[int][]rune),
+	}
+}
+
+// readLine reads a line, returning io.EOF for ctrl-d on an empty line and errInterrupt for ctrl-c
+func (editor *editor) readLine() (string, error) {
+	editor.refresh()
+
+	for {
+		r, _, err := editor.in.ReadRune()
+		if err != nil {
+			if err == io.EOF && len(editor.line) > 0 {
+				editor.write("\r\n")
+				return string(editor.line), nil
+			}
+			return "", err
+		}
+
+		switch r {
+		case keyCR, keyLF:
+			editor.pos = len(editor.line)
+			editor.refresh()
+			editor.write("\r\n")
+			return string(editor.line), nil
+		case keyCtrlC:
+			editor.write("^C\r\n")
+			return "", errInterrupt
+		case keyCtrlD:
+			if len(editor.line) == 0 {
+				return "", io.EOF
+			}
+			editor.deleteRunes(editor.pos, editor.pos+1)
+		case keyCtrlA:
+			editor.pos = 0
+		case keyCtrlE:
+			editor.pos = len(editor.line)
+		case keyCtrlB:
+			editor.moveLeft()
+		case keyCtrlF:
+			editor.moveRight()
+		case keyBackspace, keyCtrlH:
+			if editor.pos > 0 {
+				editor.deleteRunes(editor.pos-1, editor.pos)
+			}
+		case keyCtrlK:
+			editor.deleteRunes(editor.pos, len(editor.line))
+		case keyCtrlU:
+			editor.deleteRunes(0, editor.pos)
+		case keyCtrlW:
+			editor.deleteRunes(editor.wordStart(), editor.pos)
+		case keyCtrlL:
+			editor.write("\x1b[H\x1b[2J")
+		case keyCtrlP:
+			editor.historyMove(-1)
+		case keyCtrlN:
+			editor.historyMove(1)
+		case keyTab:
+			editor.completeWord()
+		case keyEscape:
+			editor.escape()
+		default:
+			if unicode.IsPrint(r) {
+				editor.insert([]rune{r})
+			}
+		}
+
+		editor.refresh()
+	}
+}
+
+// escape handles escape sequences for the arrow, home, end and delete keys
+func (editor *editor) escape() {
+	r, _, err := editor.in.ReadRune()
+	if err != nil || (r != '[' && r != 'O') {
+		return
+	}
+
+	r, _, err = editor.in.ReadRune()
+	if err != nil {
+		return
+	}
+	switch r {
+	case 'A':
+		editor.historyMove(-1)
+	case 'B':
+		editor.historyMove(1)
+	case 'C':
+		editor.moveRight()
+	case 'D':
+		editor.moveLeft()
+	case 'H':
+		editor.pos = 0
+	case 'F':
+		editor.pos = len(editor.line)
+	case '1', '3', '4', '7', '8':
+		// sequences ending with ~
+		next, _, err := editor.in.ReadRune()
+		if err != nil || next != '~' {
+			return
+		}
+		switch r {
+		case '1', '7':
+			editor.pos = 0
+		case '4', '8':
+			editor.pos = len(editor.line)
+		case '3':
+			editor.deleteRunes(editor.pos, editor.pos+1)
+		}
+	}
+}
+
+// refresh redraws the prompt and line and moves the cursor to pos
+func (editor *editor) refresh() {
+	var builder strings.Builder
+	builder.WriteString("\r")
+	builder.WriteString(editor.prompt)
+	// multiline history entries are edited as a single line, showing the newlines as a symbol
+	builder.WriteString(strings.Replace(string(editor.line), "\n", newlineSymbol, -1))
+	builder.WriteString("\x1b[K")
+	if back := len(editor.line) - editor.pos; back > 0 {
+		fmt.Fprintf(&builder, "\x1b[%dD", back)
+	}
+	editor.write(builder.String())
+}
+
+func (editor *editor) write(s string) {
+	io.WriteString(editor.out, s)
+}
+
+func (editor *editor) moveLeft() {
+	if editor.pos > 0 {
+		editor.pos--
+	}
+}
+
+func (editor *editor) moveRight() {
+	if editor.pos < len(editor.line) {
+		editor.pos++
+	}
+}
+
+// insert inserts runes at pos
+func (editor *editor) insert(runes []rune) {
+	line := make([]rune, 0, len(editor.line)+len(runes))
+	line = append(line, editor.line[:editor.pos]...)
+	line = append(line, runes...)
+	line = append(line, editor.line[editor.pos:]...)
+	editor.line = line
+	editor.pos += len(runes)
+}
+
+// deleteRunes deletes the runes from start to end, moving pos to start
+func (editor *editor) deleteRunes(start int, end int) {
+	if end > len(editor.line) {
+		end = len(editor.line)
+	}
+	if start >= end {
+		return
+	}
+	editor.line = append(editor.line[:start], editor.line[end:]...)
+	editor.pos = start
+}
+
+// wordStart returns the start of the word before pos
+func (editor *editor) wordStart() int {
+	start := editor.pos
+	for start > 0 && editor.line[start-1] == ' ' {
+		start--
+	}
+	for start > 0 && editor.line[start-1] != ' ' {
+		start--
+	}
+	return start
+}
+
+// historyMove moves through history by delta, keeping the edits of each entry
+func (editor *editor) historyMove(delta int) {
+	index := editor.historyIndex + delta
+	if index < 0 || index > len(editor.history) {
+		return
+	}
+
+	editor.edits[editor.historyIndex] = editor.line
+	editor.historyIndex = index
+	if line, ok := editor.edits[index]; ok {
+		editor.line = line
+	} else {
+		editor.line = []rune(editor.history[index])
+	}
+	editor.pos = len(editor.line)
+}
+
+// completeWord completes the word before pos, listing the candidates when there is more than one
+func (editor *editor) completeWord() {
+	if editor.complete == nil {
+		return
+	}
+	candidates, start := editor.complete(editor.line, editor.pos)
+	if len(candidates) == 0 {
+		return
+	}
+
+	prefix := []rune(commonPrefix(candidates))
+	if len(prefix) > editor.pos-start {
+		editor.deleteRunes(start, editor.pos)
+		editor.insert(prefix)
+		return
+	}
+	if len(candidates) == 1 {
+		return
+	}
+
+	editor.write("\r\n" + strings.Join(candidates, "  ") + "\r\n")
+}
+
+// commonPrefix returns the longest common prefix of the strings
+func commonPrefix(strs []string) string {
+	prefix := []rune(strs[0])
+	for _, s := range strs[1:] {
+		runes := []rune(s)
+		i := 0
+		for i < len(prefix) && i < len(runes) && prefix[i] == runes[i] {
+			i++
+		}
+		prefix = prefix[:i]
+	}
+	return string(prefix)
+}
@@ -0,0 +1,141 @@
+package repl
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// formatMaxDepth is the depth of nested values formatted before printing ...
+const formatMaxDepth = 8
+
+var (
+	contextType      = reflect.TypeOf((*context.Context)(nil)).Elem()
+	reflectValueType = reflect.TypeOf(reflect.Value{})
+	errorType        = reflect.TypeOf((*error)(nil)).Elem()
+	stringerType     = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// Format returns value formatted for display, similar to the script syntax for the value.
+// Strings are quoted, maps are printed with sorted keys, errors and fmt.Stringer values with their methods.
+func Format(value interface{}) string {
+	var builder strings.Builder
+	format(&builder, reflect.ValueOf(value), 0)
+	return builder.String()
+}
+
+func format(builder *strings.Builder, value reflect.Value, depth int) {
+	if !value.IsValid() {
+		builder.WriteString("nil")
+		return
+	}
+	if depth > formatMaxDepth {
+		builder.WriteString("...")
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		if value.IsNil() {
+			builder.WriteString("nil")
+			return
+		}
+	}
+
+	if value.Kind() != reflect.Interface && value.CanInterface() {
+		if value.Type().Implements(errorType) {
+			builder.WriteString(value.Interface().(error).Error())
+			return
+		}
+		if value.Type().Implements(stringerType) {
+			builder.WriteString(value.Interface().(fmt.Stringer).String())
+			return
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Interface:
+		format(builder, value.Elem(), depth)
+	case reflect.String:
+		builder.WriteString(strconv.Quote(value.String()))
+	case reflect.Ptr:
+		if value.Elem().Kind() == reflect.Struct {
+			builder.WriteString("&")
+			format(builder, value.Elem(), depth)
+			return
+		}
+		fmt.Fprintf(builder, "(%v)(%#x)", value.Type(), value.Pointer())
+	case reflect.Slice, reflect.Array:
+		builder.WriteString("[")
+		for i := 0; i < value.Len(); i++ {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			format(builder, value.Index(i), depth+1)
+		}
+		builder.WriteString("]")
+	case reflect.Map:
+		formatMap(builder, value, depth)
+	case reflect.Struct:
+		builder.WriteString(value.Type().String())
+		builder.WriteString("{")
+		for i := 0; i < value.NumField(); i++ {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(value.Type().Field(i).Name)
+			builder.WriteString(": ")
+			format(builder, value.Field(i), depth+1)
+		}
+		builder.WriteString("}")
+	case reflect.Func:
+		if isScriptFunc(value.Type()) {
+			builder.WriteString("func")
+			return
+		}
+		builder.WriteString(value.Type().String())
+	case reflect.Chan:
+		builder.WriteString(value.Type().String())
+	default:
+		if value.CanInterface() {
+			fmt.Fprint(builder, value.Interface())
+			return
+		}
+		fmt.Fprint(builder, value)
+	}
+}
+
+// formatMap formats a map with the keys sorted by their formatted value
+func formatMap(builder *strings.Builder, value reflect.Value, depth int) {
+	type entry struct {
+		key   string
+		value reflect.Value
+	}
+	entries := make([]entry, 0, value.Len())
+	for _, key := range value.MapKeys() {
+		var keyBuilder strings.Builder
+		format(&keyBuilder, key, depth+1)
+		entries = append(entries, entry{key: keyBuilder.String(), value: value.MapIndex(key)})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+
+	builder.WriteString("{")
+	for i, entry := range entries {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(entry.key)
+		builder.WriteString(": ")
+		format(builder, entry.value, depth+1)
+	}
+	builder.WriteString("}")
+}
+
+// isScriptFunc returns true if the func type is the type of a function defined in a script
+func isScriptFunc(t reflect.Type) bool {
+	return t.NumIn() > 0 && t.In(0) == contextType && t.NumOut() == 2 &&
+		t.Out(0) == reflectValueType && t.Out(1) == reflectValueType
+}
@@ -0,0 +1,270 @@
+// Package repl implements an interactive read eval print loop for anko.
+//
+// When Terminal is set, input is read with a line editor supporting cursor movement,
+// history and tab completion of env symbols and package members.
+// Otherwise input is read line by line, which is used for pipes and scripted input.
+package repl
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/vm"
+)
+
+// REPL is an interactive read eval print loop.
+type REPL struct {
+	// Env is the env the input is run in.
+	Env *env.Env
+	// Options are the options to run the input with.
+	Options *vm.Options
+	// EnvSetupFunc sets up the new env created by the :reset command.
+	EnvSetupFunc func(*env.Env)
+
+	// Prompt is the prompt for new input.
+	Prompt string
+	// ContinuePrompt is the prompt for more lines of multiline input.
+	ContinuePrompt string
+
+	// Terminal enables the line editor. In must be a terminal in raw mode while reading,
+	// see RawModeFunc.
+	Terminal bool
+	// RawModeFunc is called before reading a line with the line editor
+	// and the returned function after, to set and restore the terminal raw mode.
+	RawModeFunc func() (restore func() error, err error)
+
+	// HistoryFile is the file history is loaded from and saved to. Empty for no history file.
+	HistoryFile string
+	// HistorySize is the maximum number of history entries.
+	HistorySize int
+
+	in      *bufio.Reader
+	out     io.Writer
+	errOut  io.Writer
+	history []string
+}
+
+// errInterrupt is returned by the line editor when the input is interrupted by ctrl-c
+var errInterrupt = errors.New("interrupt")
+
+// New returns a new REPL running input from in in env e, writing results to out and errors to errOut.
+func New(e *env.Env, in io.Reader, out io.Writer, errOut io.Writer) *REPL {
+	return &REPL{
+		Env:            e,
+		Prompt:         "> ",
+		ContinuePrompt: "  ",
+		HistorySize:    1000,
+		in:             bufio.NewReader(in),
+		out:            out,
+		errOut:         errOut,
+	}
+}
+
+// DefaultHistoryFile returns the default history file, ~/.anko_history, or empty string if there is no home directory.
+func DefaultHistoryFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".anko_history")
+}
+
+// Run runs the REPL until the input ends, quit() or :quit is entered or ctx is done.
+// When ctx is done it returns the error of ctx after printing the result of the current input.
+// Input starting with a colon is a command, :help lists the commands.
+func (repl *REPL) Run(ctx context.Context) error {
+	repl.loadHistory()
+
+	var source string
+	for {
+		prompt := repl.Prompt
+		if source != "" {
+			prompt = repl.ContinuePrompt
+		}
+
+		line, err := repl.readLine(prompt)
+		if err == errInterrupt {
+			source = ""
+			continue
+		}
+		if err != nil {
+			if err == io.EOF {
+				if repl.Terminal {
+					fmt.Fprintln(repl.out)
+				}
+				return nil
+			}
+			return err
+		}
+
+		if source != "" {
+			source += "\n"
+		}
+		source += line
+		if strings.TrimSpace(source) == "" {
+			source = ""
+			continue
+		}
+		if parser.IsIncomplete(source) {
+			continue
+		}
+
+		repl.addHistory(source)
+		if strings.TrimSpace(source) == "quit()" {
+			return nil
+		}
+
+		if strings.HasPrefix(strings.TrimSpace(source), ":") {
+			err = repl.runCommand(ctx, strings.TrimSpace(source))
+			if err == errQuit {
+				return nil
+			}
+			if err != nil {
+				repl.printError(err)
+			}
+		} else {
+			repl.eval(ctx, source)
+		}
+		source = ""
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// eval parses and runs source, printing the result or error
+func (repl *REPL) eval(ctx context.Context, source string) {
+	value, err := repl.run(ctx, source)
+	if err != nil {
+		repl.printError(err)
+		return
+	}
+
+	fmt.Fprintln(repl.out, Format(value))
+}
+
+// run parses and runs source in the env
+func (repl *REPL) run(ctx context.Context, source string) (interface{}, error) {
+	stmt, err := parser.ParseSrc(source)
+	if err != nil {
+		return nil, err
+	}
+	return vm.RunContext(ctx, repl.Env, repl.Options, stmt)
+}
+
+// printError prints err with its position for parser and VM errors
+func (repl *REPL) printError(err error) {
+	fmt.Fprintln(repl.errOut, formatError(err))
+}
+
+// formatError returns the error message prefixed with the position for parser and VM errors
+func formatError(err error) string {
+	switch e := err.(type) {
+	case *vm.Error:
+		return fmt.Sprintf("%d:%d %s", e.Pos.Line, e.Pos.Column, err)
+	case *parser.Error:
+		return fmt.Sprintf("%d:%d %s", e.Pos.Line, e.Pos.Column, err)
+	}
+	return err.Error()
+}
+
+// readLine reads a line of input, with the line editor if Terminal is set
+func (repl *REPL) readLine(prompt string) (string, error) {
+	if !repl.Terminal {
+		fmt.Fprint(repl.out, prompt)
+		line, err := repl.in.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return "", err
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+
+	if repl.RawModeFunc != nil {
+		restore, err := repl.RawModeFunc()
+		if err != nil {
+			return "", err
+		}
+		defer restore()
+	}
+
+	editor := newEditor(repl.in, repl.out, prompt, repl.history, repl.complete)
+	return editor.readLine()
+}
+
+// loadHistory loads the history file
+func (repl *REPL) loadHistory() {
+	if repl.HistoryFile == "" {
+		return
+	}
+	data, err := ioutil.ReadFile(repl.HistoryFile)
+	if err != nil {
+		return
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line != "" {
+			repl.history = append(repl.history, unescapeHistory(line))
+		}
+	}
+	if len(repl.history) > repl.HistorySize {
+		repl.history = repl.history[len(repl.history)-repl.HistorySize:]
+	}
+}
+
+// addHistory adds the entry to history and appends it to the history file
+func (repl *REPL) addHistory(entry string) {
+	if len(repl.history) > 0 && repl.history[len(repl.history)-1] == entry {
+		return
+	}
+	repl.history = append(repl.history, entry)
+	if len(repl.history) > repl.HistorySize {
+		repl.history = repl.history[len(repl.history)-repl.HistorySize:]
+	}
+
+	if repl.HistoryFile == "" {
+		return
+	}
+	file, err := os.OpenFile(repl.HistoryFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return
+	}
+	fmt.Fprintln(file, escapeHistory(entry))
+	file.Close()
+}
+
+// History returns the history entries, oldest first.
+func (repl *REPL) History() []string {
+	history := make([]string, len(repl.history))
+	copy(history, repl.history)
+	return history
+}
+
+// escapeHistory escapes a multiline history entry to a single line
+func escapeHistory(entry string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(entry)
+}
+
+// unescapeHistory reverses escapeHistory
+func unescapeHistory(line string) string {
+	var builder strings.Builder
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' && i+1 < len(line) {
+			i++
+			if line[i] == 'n' {
+				builder.WriteByte('\n')
+				continue
+			}
+		}
+		builder.WriteByte(line[i])
+	}
+	return builder.String()
+}
@@ -0,0 +1,338 @@
+package repl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/vm"
+)
+
+func runREPL(t *testing.T, e *env.Env, input string, terminal bool, historyFile string) (*REPL, string, string) {
+	t.Helper()
+	var out, errOut bytes.Buffer
+	repl := New(e, strings.NewReader(input), &out, &errOut)
+	repl.Terminal = terminal
+	repl.HistoryFile = historyFile
+	err := repl.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+	return repl, out.String(), errOut.String()
+}
+
+func TestRunPlain(t *testing.T) {
+	tests := []struct {
+		input     string
+		outputs   string
+		errOutput string
+	}{
+		{input: "1 + 1\n", outputs: "> 2\n> "},
+		{input: "a = 1\nb = 2\na + b", outputs: "> 1\n> 2\n> 3\n> "},
+		{input: "a = [1,\n2]\nlen(a)\n", outputs: ">   [1, 2]\n> 2\n> "},
+		{input: "a = 1 +\n2\n", outputs: ">   3\n> "},
+		{input: "a, b = 1,\n2\na + b\n", outputs: ">   2\n> 3\n> "},
+		{input: "a = 1 +\n)\n", outputs: ">   > ", errOutput: "2:1 syntax error\n"},
+		{input: "\n\n1\n", outputs: "> > > 1\n> "},
+		{input: "func f(x) {\nreturn x * 2\n}\nf(2)\n", outputs: ">     func\n> 4\n> "},
+		{input: "a = `x\ny`\n", outputs: ">   \"x\\ny\"\n> "},
+		{input: "quit()\n1\n", outputs: "> "},
+		{input: "b\n1\n", outputs: "> > 1\n> ", errOutput: "1:1 undefined symbol 'b'\n"},
+		{input: "..\n", outputs: "> > ", errOutput: "1:1 syntax error on '.' at 1:1\n"},
+		{input: "throw \"x\"\n", outputs: "> > ", errOutput: "1:1 x\n"},
+	}
+
+	for _, test := range tests {
+		_, outputs, errOutput := runREPL(t, env.NewEnv(), test.input, false, "")
+		if outputs != test.outputs {
+			t.Errorf("output - received: %q - expected: %q - input: %q", outputs, test.outputs, test.input)
+		}
+		if errOutput != test.errOutput {
+			t.Errorf("error output - received: %q - expected: %q - input: %q", errOutput, test.errOutput, test.input)
+		}
+	}
+}
+
+func TestRunTerminal(t *testing.T) {
+	tests := []struct {
+		input   string
+		results []string
+		history []string
+	}{
+		// editing
+		{input: "2 * 3\x011 + \r", results: []string{"7"}, history: []string{"1 + 2 * 3"}},
+		{input: "12\x1b[D3\x1b[C4\r", results: []string{"1324"}, history: []string{"1324"}},
+		{input: "123\x1b[H\x1b[3~\x1b[F5\r", results: []string{"235"}, history: []string{"235"}},
+		{input: "1 + 256\x7f\x08\r", results: []string{"3"}, history: []string{"1 + 2"}},
+		{input: "12345\x02\x02\x0b\x02\x15\r", results: []string{"3"}, history: []string{"3"}},
+		{input: "a = 1 + 2\x17\x17+ 7\r", results: []string{"8"}, history: []string{"a = 1 + 7"}},
+		{input: "1\x03", results: nil, history: nil},
+		{input: "\x04", results: nil, history: nil},
+		{input: "12\x04\x02\x04\r", results: []string{"1"}, history: []string{"1"}},
+		{input: "1\x1b[1~2\x1b[4~3\r", results: []string{"213"}, history: []string{"213"}},
+		// history
+		{input: "1\r2\r\x1b[A\x1b[A\r", results: []string{"1", "2", "1"}, history: []string{"1", "2", "1"}},
+		{input: "1\r2\r\x10\x10\x0e\r", results: []string{"1", "2", "2"}, history: []string{"1", "2"}},
+		{input: "1\r\x1b[A0\x1b[B\x1b[A\r", results: []string{"1", "10"}, history: []string{"1", "10"}},
+		{input: "[1,\r2]\r\x1b[A\r", results: []string{"[1, 2]", "[1, 2]"}, history: []string{"[1,\n2]"}},
+		// completion
+		{input: "abc = 1\rab\t\r", results: []string{"1", "1"}, history: []string{"abc = 1", "abc"}},
+	}
+
+	for _, test := range tests {
+		repl, outputs, _ := runREPL(t, env.NewEnv(), test.input, true, "")
+		var results []string
+		for _, line := range strings.Split(outputs, "\n") {
+			line = strings.TrimSuffix(line, "\r")
+			if !strings.HasPrefix(line, "\r") && line != "" {
+				results = append(results, line)
+			}
+		}
+		if !reflect.DeepEqual(results, test.results) {
+			t.Errorf("results - received: %q - expected: %q - input: %q", results, test.results, test.input)
+		}
+		history := repl.History()
+		if len(history) == 0 {
+			history = nil
+		}
+		if !reflect.DeepEqual(history, test.history) {
+			t.Errorf("history - received: %q - expected: %q - input: %q", history, test.history, test.input)
+		}
+	}
+}
+
+func TestRunContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	e := env.NewEnv()
+	e.Define("stop", cancel)
+
+	var out, errOut bytes.Buffer
+	repl := New(e, strings.NewReader("1\nstop()\n2\n"), &out, &errOut)
+	err := repl.Run(ctx)
+	if err != context.Canceled {
+		t.Errorf("Run error - received: %v - expected: %v", err, context.Canceled)
+	}
+	expected := "> 1\n> nil\n"
+	if out.String() != expected {
+		t.Errorf("output - received: %q - expected: %q", out.String(), expected)
+	}
+}
+
+func TestRawModeFunc(t *testing.T) {
+	var out, errOut bytes.Buffer
+	repl := New(env.NewEnv(), strings.NewReader("1\r2\r"), &out, &errOut)
+	repl.Terminal = true
+	var raw, restored int
+	repl.RawModeFunc = func() (func() error, error) {
+		raw++
+		return func() error {
+			restored++
+			return nil
+		}, nil
+	}
+	err := repl.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+	if raw != 3 || restored != 3 {
+		t.Errorf("raw mode set %v and restored %v times - expected 3", raw, restored)
+	}
+
+	repl = New(env.NewEnv(), strings.NewReader("1\r"), &out, &errOut)
+	repl.Terminal = true
+	repl.RawModeFunc = func() (func() error, error) {
+		return nil, errors.New("not a terminal")
+	}
+	err = repl.Run(context.Background())
+	if err == nil || err.Error() != "not a terminal" {
+		t.Errorf("Run error - received: %v - expected: not a terminal", err)
+	}
+}
+
+func TestHistoryFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "anko-repl")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(dir)
+	historyFile := filepath.Join(dir, "history")
+
+	runREPL(t, env.NewEnv(), "a = 1\na = 1\nb = `x\\y\nz`\n", false, historyFile)
+
+	data, err := ioutil.ReadFile(historyFile)
+	if err != nil {
+		t.Fatal("ReadFile error:", err)
+	}
+	expected := "a = 1\nb = `x\\\\y\\nz`\n"
+	if string(data) != expected {
+		t.Errorf("history file - received: %q - expected: %q", data, expected)
+	}
+
+	repl, _, _ := runREPL(t, env.NewEnv(), "\x1b[A\x1b[A\x05 + 1\r", true, historyFile)
+	history := repl.History()
+	expectedHistory := []string{"a = 1", "b = `x\\y\nz`", "a = 1 + 1"}
+	if !reflect.DeepEqual(history, expectedHistory) {
+		t.Errorf("history - received: %q - expected: %q", history, expectedHistory)
+	}
+
+	repl = New(env.NewEnv(), strings.NewReader(""), ioutil.Discard, ioutil.Discard)
+	repl.HistoryFile = historyFile
+	repl.HistorySize = 2
+	err = repl.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+	history = repl.History()
+	expectedHistory = []string{"b = `x\\y\nz`", "a = 1 + 1"}
+	if !reflect.DeepEqual(history, expectedHistory) {
+		t.Errorf("history - received: %q - expected: %q", history, expectedHistory)
+	}
+}
+
+type testCompleteStruct struct {
+	Name    string
+	private int
+}
+
+func (testCompleteStruct) Hello() {}
+
+func TestComplete(t *testing.T) {
+	e := env.NewEnv()
+	e.Define("println", func() {})
+	e.Define("printValue", 1)
+	e.Define("s", testCompleteStruct{})
+	e.Define("m", map[string]interface{}{"key": map[string]interface{}{"inner": 1}, "other": 2})
+	module, _ := e.NewModule("mod")
+	module.Define("Value", 1)
+	module.DefineType("Type", 1)
+
+	tests := []struct {
+		line       string
+		candidates []string
+		start      int
+	}{
+		{line: "pri", candidates: []string{"printValue", "println"}, start: 0},
+		{line: "a = pri", candidates: []string{"printValue", "println"}, start: 4},
+		{line: "ret", candidates: []string{"return"}, start: 0},
+		{line: "xyz", candidates: nil, start: 0},
+		{line: "s.", candidates: []string{"Hello", "Name"}, start: 2},
+		{line: "s.N", candidates: []string{"Name"}, start: 2},
+		{line: "m.k", candidates: []string{"key"}, start: 2},
+		{line: "m.key.", candidates: []string{"inner"}, start: 6},
+		{line: "mod.", candidates: []string{"Type", "Value"}, start: 4},
+		{line: "x.", candidates: nil, start: 0},
+		{line: `import("`, candidates: []string{"encoding/json", "fmt", "strings"}, start: 8},
+		{line: `import("str`, candidates: []string{"strings"}, start: 8},
+		{line: `import("encoding/j`, candidates: []string{"encoding/json"}, start: 8},
+	}
+
+	packages := env.Packages
+	env.Packages = map[string]map[string]reflect.Value{"fmt": nil, "strings": nil, "encoding/json": nil}
+	defer func() { env.Packages = packages }()
+
+	repl := New(e, strings.NewReader(""), ioutil.Discard, ioutil.Discard)
+	for _, test := range tests {
+		line := []rune(test.line)
+		candidates, start := repl.complete(line, len(line))
+		if !reflect.DeepEqual(candidates, test.candidates) || (len(candidates) > 0 && start != test.start) {
+			t.Errorf("complete - received: %q, %v - expected: %q, %v - line: %q", candidates, start, test.candidates, test.start, test.line)
+		}
+	}
+}
+
+type testFormatStruct struct {
+	A int
+	B *testFormatStruct
+}
+
+type testFormatStringer int
+
+func (testFormatStringer) String() string { return "stringer" }
+
+func TestFormat(t *testing.T) {
+	scriptFunc, err := vm.Execute(env.NewEnv(), nil, "func(a) { return a }")
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{value: nil, expected: "nil"},
+		{value: 1, expected: "1"},
+		{value: int64(-1), expected: "-1"},
+		{value: 1.5, expected: "1.5"},
+		{value: true, expected: "true"},
+		{value: "a\"b\n", expected: `"a\"b\n"`},
+		{value: []interface{}{1, "a", nil, []int{2}}, expected: `[1, "a", nil, [2]]`},
+		{value: []int(nil), expected: "nil"},
+		{value: map[interface{}]interface{}{"b": 2, "a": 1}, expected: `{"a": 1, "b": 2}`},
+		{value: map[string]interface{}{}, expected: "{}"},
+		{value: testFormatStruct{A: 1, B: &testFormatStruct{A: 2}}, expected: "repl.testFormatStruct{A: 1, B: &repl.testFormatStruct{A: 2, B: nil}}"},
+		{value: errors.New("error"), expected: "error"},
+		{value: testFormatStringer(1), expected: "stringer"},
+		{value: strings.ToUpper, expected: "func(string) string"},
+		{value: scriptFunc, expected: "func"},
+		{value: [][][][][][][][][][]int{{{{{{{{{{1}}}}}}}}}}, expected: "[[[[[[[[[...]]]]]]]]]"},
+	}
+
+	for _, test := range tests {
+		formatted := Format(test.value)
+		if formatted != test.expected {
+			t.Errorf("Format - received: %v - expected: %v - value: %#v", formatted, test.expected, test.value)
+		}
+	}
+
+	value := &testFormatStruct{A: 1}
+	value.B = value
+	if formatted := Format(value); !strings.HasSuffix(formatted, "B: ...}}}}}}}}}") {
+		t.Errorf("Format - received: %v - expected cyclic value to end with ...", formatted)
+	}
+}
+
+func TestIsIncomplete(t *testing.T) {
+	tests := []struct {
+		src        string
+		incomplete bool
+	}{
+		{src: "", incomplete: false},
+		{src: "a = 1", incomplete: false},
+		{src: "a = 1 +", incomplete: true},
+		{src: "a = 1 +\n", incomplete: true},
+		{src: "a = 1 +\n2", incomplete: false},
+		{src: "a, b = 1,", incomplete: true},
+		{src: "a = b ==", incomplete: true},
+		{src: "a++", incomplete: false},
+		{src: "if a {", incomplete: true},
+		{src: "if a {\n}", incomplete: false},
+		{src: "if a", incomplete: false},
+		{src: "a = [1,\n2", incomplete: true},
+		{src: "a = [1,\n2]", incomplete: false},
+		{src: "func a(", incomplete: true},
+		{src: "a = `abc", incomplete: true},
+		{src: "/* comment", incomplete: true},
+		{src: "1 + 1 // comment", incomplete: false},
+		{src: "a = \"abc", incomplete: false},
+		{src: "..", incomplete: false},
+		{src: "var , b = 1, 2", incomplete: false},
+		{src: "}", incomplete: false},
+		{src: "a = \"{\"", incomplete: false},
+	}
+
+	for _, test := range tests {
+		incomplete := parser.IsIncomplete(test.src)
+		if incomplete != test.incomplete {
+			t.Errorf("IsIncomplete - received: %v - expected: %v - src: %q", incomplete, test.incomplete, test.src)
+		}
+	}
+}
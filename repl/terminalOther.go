@@ -0,0 +1,17 @@
+// +build !linux,!darwin,!dragonfly,!freebsd,!netbsd,!openbsd
+
+package repl
+
+import "errors"
+
+// IsTerminal returns true if the file descriptor is a terminal.
+// Terminals are not supported on this platform, so it always returns false.
+func IsTerminal(fd uintptr) bool {
+	return false
+}
+
+// MakeRaw puts the terminal of the file descriptor in raw mode, returning a function to restore the previous mode.
+// Terminals are not supported on this platform, so it always returns an error.
+func MakeRaw(fd uintptr) (restore func() error, err error) {
+	return nil, errors.New("terminal raw mode not supported")
+}
@@ -0,0 +1,56 @@
+// +build linux darwin dragonfly freebsd netbsd openbsd
+
+package repl
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// IsTerminal returns true if the file descriptor is a terminal.
+func IsTerminal(fd uintptr) bool {
+	var termios syscall.Termios
+	return getTermios(fd, &termios) == nil
+}
+
+// MakeRaw puts the terminal of the file descriptor in raw mode, returning a function to restore the previous mode.
+// Output processing is kept, so a newline still moves to the start of the next line.
+func MakeRaw(fd uintptr) (restore func() error, err error) {
+	var old syscall.Termios
+	err = getTermios(fd, &old)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := old
+	raw.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	raw.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	raw.Cflag &^= syscall.CSIZE | syscall.PARENB
+	raw.Cflag |= syscall.CS8
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+	err = setTermios(fd, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		return setTermios(fd, &old)
+	}, nil
+}
+
+func getTermios(fd uintptr, termios *syscall.Termios) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, ioctlGetTermios, uintptr(unsafe.Pointer(termios)))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+func setTermios(fd uintptr, termios *syscall.Termios) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, ioctlSetTermios, uintptr(unsafe.Pointer(termios)))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
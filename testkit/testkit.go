@@ -0,0 +1,78 @@
+// Package testkit is a table driven test harness for testing anko builtins and package bindings.
+//
+// Each Test runs a script in a new env, checking the parse error, run error, run output and the values of env symbols:
+//
+//	tests := []testkit.Test{
+//		{Script: `a = toString(b)`, Input: map[string]interface{}{"b": 1}, RunOutput: "1", Output: map[string]interface{}{"a": "1"}},
+//		{Script: `toString(`, ParseError: fmt.Errorf("syntax error")},
+//	}
+//	testkit.Run(t, tests, &testkit.Options{EnvSetupFunc: &envSetupFunc})
+package testkit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/internal/testlib"
+	"github.com/mattn/anko/vm"
+)
+
+// Test is a script test, with the fields:
+//
+//	Script         the script to parse and run, still run after a parse error
+//	ParseError     the expected parse error, compared by error message
+//	ParseErrorFunc checks the parse error instead of ParseError
+//	EnvSetupFunc   sets up the env of the test, after Options EnvSetupFunc
+//	Types          defined as types in the env before running the script
+//	Input          defined as values in the env before running the script
+//	RunError       the expected run error, compared by error message
+//	RunErrorFunc   checks the run error instead of RunError
+//	RunOutput      the expected value returned by the script
+//	Output         the expected values of env symbols after running the script
+type Test = testlib.Test
+
+// Options are the options for running tests.
+type Options struct {
+	// EnvSetupFunc sets up the env of every test.
+	EnvSetupFunc *func(*testing.T, *env.Env)
+	// Timeout is the context timeout for running the script of each test. Defaults to 60 seconds.
+	Timeout time.Duration
+	// VMOptions are the options to run the VM with.
+	VMOptions *vm.Options
+}
+
+// Run runs the tests.
+func Run(t *testing.T, tests []Test, options *Options) {
+	t.Helper()
+	for _, test := range tests {
+		RunTest(t, test, options)
+	}
+}
+
+// RunTest runs the test, reporting any differences as errors on t.
+func RunTest(t *testing.T, test Test, options *Options) {
+	t.Helper()
+	var testOptions *testlib.Options
+	var vmOptions *vm.Options
+	if options != nil {
+		testOptions = &testlib.Options{EnvSetupFunc: options.EnvSetupFunc, Timeout: options.Timeout}
+		vmOptions = options.VMOptions
+	}
+	testlib.RunTest(t, test, testOptions, func(ctx context.Context, e *env.Env, stmt ast.Stmt) (interface{}, error) {
+		return vm.RunContext(ctx, e, vmOptions, stmt)
+	})
+}
+
+// ErrorEqual returns true if both errors are nil or both have the same error message.
+func ErrorEqual(err1 error, err2 error) bool {
+	return testlib.ErrorEqual(err1, err2)
+}
+
+// ValueEqual return true if v1 and v2 is same value. If passed function, does
+// extra checks otherwise just doing reflect.DeepEqual
+func ValueEqual(v1 interface{}, v2 interface{}) bool {
+	return testlib.ValueEqual(v1, v2)
+}
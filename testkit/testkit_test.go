@@ -0,0 +1,74 @@
+package testkit
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/vm"
+)
+
+func TestRun(t *testing.T) {
+	double := func(a int64) int64 { return a * 2 }
+	envSetupFunc := func(t *testing.T, e *env.Env) {
+		e.Define("double", double)
+	}
+	parseErrorFunc := func(t *testing.T, err error) {
+		if err == nil || !strings.HasPrefix(err.Error(), "syntax error") {
+			t.Errorf("ParseSrc error - received: %v - expected: %v", err, "syntax error")
+		}
+	}
+	runErrorFunc := func(t *testing.T, err error) {
+		if err == nil || !strings.Contains(err.Error(), "undefined symbol") {
+			t.Errorf("Run error - received: %v - expected: %v", err, "undefined symbol")
+		}
+	}
+	valueSetupFunc := func(t *testing.T, e *env.Env) {
+		e.Define("c", int64(3))
+	}
+
+	tests := []Test{
+		{Script: `a = double(b)`, Input: map[string]interface{}{"b": int64(2)}, RunOutput: int64(4), Output: map[string]interface{}{"a": int64(4), "b": int64(2)}},
+		{Script: `a = double(c)`, EnvSetupFunc: &valueSetupFunc, RunOutput: int64(6), Output: map[string]interface{}{"a": int64(6)}},
+		{Script: `a = make(b)`, Types: map[string]interface{}{"b": int32(0)}, RunOutput: int32(0)},
+		{Script: `a = `, ParseError: fmt.Errorf("syntax error")},
+		{Script: `a = `, ParseErrorFunc: &parseErrorFunc},
+		{Script: `throw "error"`, RunError: fmt.Errorf("error"), RunOutput: "error"},
+		{Script: `a`, RunErrorFunc: &runErrorFunc},
+		{Script: `double`, RunOutput: double},
+		{Script: `errors.New("a")`, EnvSetupFunc: &valueSetupFunc, RunErrorFunc: &runErrorFunc},
+		{Script: `for { }`, RunError: vm.ErrInterrupt},
+	}
+	Run(t, tests, &Options{EnvSetupFunc: &envSetupFunc, Timeout: 10 * time.Millisecond})
+
+	tests = []Test{
+		{Script: `a = b.c`, Input: map[string]interface{}{"b": nil}, RunError: fmt.Errorf("type interface does not support member operation")},
+	}
+	Run(t, tests, &Options{VMOptions: &vm.Options{Debug: false}})
+}
+
+func TestValueEqual(t *testing.T) {
+	tests := []struct {
+		v1    interface{}
+		v2    interface{}
+		equal bool
+	}{
+		{v1: nil, v2: nil, equal: true},
+		{v1: int64(1), v2: int64(1), equal: true},
+		{v1: int64(1), v2: int32(1), equal: false},
+		{v1: []interface{}{"a"}, v2: []interface{}{"a"}, equal: true},
+		{v1: fmt.Errorf("a"), v2: fmt.Errorf("a"), equal: true},
+		{v1: fmt.Errorf("a"), v2: fmt.Errorf("b"), equal: false},
+		{v1: TestValueEqual, v2: TestValueEqual, equal: true},
+		{v1: TestValueEqual, v2: TestRun, equal: false},
+		{v1: TestValueEqual, v2: func(t *testing.T, tests []Test, options *Options) {}, equal: false},
+	}
+
+	for _, test := range tests {
+		if ValueEqual(test.v1, test.v2) != test.equal {
+			t.Errorf("ValueEqual - received: %v - expected: %v - v1: %#v - v2: %#v", !test.equal, test.equal, test.v1, test.v2)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+// Package trace has a vm.Tracer that writes the events of a run as JSON lines for auditing,
+// with redaction hooks to hide sensitive arguments and values.
+//
+//	tracer := trace.NewJSONTracer(file)
+//	tracer.Redact = []trace.RedactFunc{trace.RedactCallArgs("login", 1), trace.RedactVariables(regexp.MustCompile("(?i)password|token"))}
+//	_, err := vm.Run(e, &vm.Options{Tracer: tracer}, stmt)
+//	...
+//	err = tracer.Err()
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/mattn/anko/vm"
+)
+
+// jsonMarshalerType is the type of json.Marshaler
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// Redacted is the value written in place of redacted values.
+const Redacted = "[REDACTED]"
+
+// RedactFunc returns the value to write for a value of an event.
+// Field is args, results or value, and index is the index of the value in args or results, else 0.
+// It returns value unchanged to not redact it.
+type RedactFunc func(event *vm.TraceEvent, field string, index int, value interface{}) interface{}
+
+// JSONTracer is a vm.Tracer that writes each event as a JSON object on one line.
+type JSONTracer struct {
+	// Redact are called in order for each value of an event.
+	Redact []RedactFunc
+	// Kinds are the kinds of events to write, all kinds if empty.
+	Kinds []vm.TraceKind
+	// Now returns the time of an event, time.Now if nil.
+	Now func() time.Time
+
+	mutex  sync.Mutex
+	writer io.Writer
+	err    error
+}
+
+// jsonEvent is an event line
+type jsonEvent struct {
+	Time    time.Time     `json:"time"`
+	Event   vm.TraceKind  `json:"event"`
+	Line    int           `json:"line"`
+	Column  int           `json:"column"`
+	Stmt    string        `json:"stmt,omitempty"`
+	Name    string        `json:"name,omitempty"`
+	Scope   string        `json:"scope,omitempty"`
+	Args    []interface{} `json:"args,omitempty"`
+	Results []interface{} `json:"results,omitempty"`
+	Value   interface{}   `json:"value,omitempty"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// NewJSONTracer returns a new JSONTracer that writes to writer.
+func NewJSONTracer(writer io.Writer) *JSONTracer {
+	return &JSONTracer{writer: writer}
+}
+
+// Trace writes the event. After a write error, events are not written anymore.
+func (tracer *JSONTracer) Trace(ctx context.Context, event *vm.TraceEvent) {
+	if !tracer.writeKind(event.Kind) {
+		return
+	}
+
+	line := jsonEvent{
+		Event:  event.Kind,
+		Line:   event.Pos.Line,
+		Column: event.Pos.Column,
+		Name:   event.Name,
+		Scope:  event.Scope,
+	}
+	if tracer.Now != nil {
+		line.Time = tracer.Now()
+	} else {
+		line.Time = time.Now()
+	}
+	if event.Stmt != nil {
+		line.Stmt = reflect.TypeOf(event.Stmt).Elem().Name()
+	}
+	line.Args = tracer.values(event, "args", event.Args)
+	line.Results = tracer.values(event, "results", event.Results)
+	if event.Value != nil {
+		line.Value = tracer.value(event, "value", 0, event.Value)
+	}
+	if event.Err != nil {
+		line.Error = event.Err.Error()
+	}
+
+	data, err := json.Marshal(line)
+	if err != nil {
+		// should not happen as the values are converted
+		data, _ = json.Marshal(jsonEvent{Time: line.Time, Event: line.Event, Line: line.Line, Column: line.Column, Error: err.Error()})
+	}
+	data = append(data, '\n')
+
+	tracer.mutex.Lock()
+	defer tracer.mutex.Unlock()
+	if tracer.err != nil {
+		return
+	}
+	_, tracer.err = tracer.writer.Write(data)
+}
+
+// Err returns the first write error.
+func (tracer *JSONTracer) Err() error {
+	tracer.mutex.Lock()
+	defer tracer.mutex.Unlock()
+	return tracer.err
+}
+
+// writeKind returns true if events of kind are written
+func (tracer *JSONTracer) writeKind(kind vm.TraceKind) bool {
+	if len(tracer.Kinds) == 0 {
+		return true
+	}
+	for _, writeKind := range tracer.Kinds {
+		if writeKind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// values returns the values to write for field
+func (tracer *JSONTracer) values(event *vm.TraceEvent, field string, values []interface{}) []interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	jsonValues := make([]interface{}, len(values))
+	for i, value := range values {
+		jsonValues[i] = tracer.value(event, field, i, value)
+	}
+	return jsonValues
+}
+
+// value returns the redacted value to write.
+func (tracer *JSONTracer) value(event *vm.TraceEvent, field string, index int, value interface{}) interface{} {
+	for _, redact := range tracer.Redact {
+		value = redact(event, field, index, value)
+	}
+	return jsonValue(reflect.ValueOf(value), 0)
+}
+
+// maxValueDepth is the depth of nested values written, deeper values are written as their type
+const maxValueDepth = 32
+
+// jsonValue returns the value of rv that can be marshaled to JSON.
+// Maps with keys that are not strings, like script maps, are written as objects with the keys as text.
+// Values that can not be marshaled to JSON, like functions and channels, are written as text.
+func jsonValue(rv reflect.Value, depth int) interface{} {
+	if !rv.IsValid() {
+		return nil
+	}
+	if depth > maxValueDepth {
+		return rv.Type().String()
+	}
+	switch rv.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if rv.IsNil() {
+			return nil
+		}
+		if rv.Kind() == reflect.Ptr && rv.Type().Implements(jsonMarshalerType) {
+			return rv.Interface()
+		}
+		return jsonValue(rv.Elem(), depth+1)
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return rv.Type().String()
+	case reflect.Complex64, reflect.Complex128:
+		return fmt.Sprint(rv.Interface())
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return fmt.Sprint(f)
+		}
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil
+		}
+		values := make(map[string]interface{}, rv.Len())
+		for _, key := range rv.MapKeys() {
+			values[fmt.Sprint(key.Interface())] = jsonValue(rv.MapIndex(key), depth+1)
+		}
+		return values
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && (rv.IsNil() || rv.Type().Elem().Kind() == reflect.Uint8) {
+			return rv.Interface()
+		}
+		values := make([]interface{}, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			values[i] = jsonValue(rv.Index(i), depth+1)
+		}
+		return values
+	}
+	if !rv.CanInterface() {
+		return rv.Type().String()
+	}
+	return rv.Interface()
+}
+
+// RedactCallArgs returns a RedactFunc that redacts the arguments of the calls to the function with name,
+// the arguments at indexes or all arguments if no indexes.
+// Name is the name in the script for Go functions, like strings.ToUpper, and the function name for script functions.
+func RedactCallArgs(name string, indexes ...int) RedactFunc {
+	return func(event *vm.TraceEvent, field string, index int, value interface{}) interface{} {
+		if field != "args" || event.Name != name || (event.Kind != vm.TraceGoCall && event.Kind != vm.TraceScriptCall) {
+			return value
+		}
+		if len(indexes) == 0 {
+			return Redacted
+		}
+		for _, redactIndex := range indexes {
+			if redactIndex == index {
+				return Redacted
+			}
+		}
+		return value
+	}
+}
+
+// RedactVariables returns a RedactFunc that redacts the values written to the variables with names matching pattern.
+func RedactVariables(pattern *regexp.Regexp) RedactFunc {
+	return func(event *vm.TraceEvent, field string, index int, value interface{}) interface{} {
+		if event.Kind != vm.TraceVarWrite || field != "value" || !pattern.MatchString(event.Name) {
+			return value
+		}
+		return Redacted
+	}
+}
@@ -0,0 +1,90 @@
+package trace
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/vm"
+)
+
+func TestJSONTracer(t *testing.T) {
+	script := `
+password = "secret"
+login("admin", password)
+c = make(chan int64)
+m = {"a": 1, "b": [1.5, {"c": nil}]}
+loginContext("admin", password)
+`
+	stmt, err := parser.ParseSrc(script)
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	e := env.NewEnv()
+	e.Define("login", func(user string, password string) bool { return user == "admin" && password == "secret" })
+	e.Define("loginContext", func(ctx context.Context, user string, password string) bool {
+		return user == "admin" && password == "secret"
+	})
+
+	var buffer bytes.Buffer
+	tracer := NewJSONTracer(&buffer)
+	tracer.Kinds = []vm.TraceKind{vm.TraceGoCall, vm.TraceVarWrite, vm.TraceStmtEnter}
+	tracer.Redact = []RedactFunc{RedactCallArgs("login", 1), RedactCallArgs("loginContext", 1), RedactVariables(regexp.MustCompile("^pass"))}
+	tracer.Now = func() time.Time { return time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC) }
+	_, err = vm.Run(e, &vm.Options{Tracer: tracer}, stmt)
+	if err != nil {
+		t.Fatal("Run error:", err)
+	}
+	if tracer.Err() != nil {
+		t.Fatal("Err error:", tracer.Err())
+	}
+
+	expected := `{"time":"2020-01-02T03:04:05Z","event":"stmt_enter","line":2,"column":1,"stmt":"LetsStmt"}
+{"time":"2020-01-02T03:04:05Z","event":"var_write","line":2,"column":1,"name":"password","scope":"global","value":"[REDACTED]"}
+{"time":"2020-01-02T03:04:05Z","event":"stmt_enter","line":3,"column":1,"stmt":"ExprStmt"}
+{"time":"2020-01-02T03:04:05Z","event":"go_call","line":3,"column":1,"name":"login","args":["admin","[REDACTED]"],"results":[true]}
+{"time":"2020-01-02T03:04:05Z","event":"stmt_enter","line":4,"column":1,"stmt":"LetsStmt"}
+{"time":"2020-01-02T03:04:05Z","event":"var_write","line":4,"column":1,"name":"c","scope":"global","value":"chan int64"}
+{"time":"2020-01-02T03:04:05Z","event":"stmt_enter","line":5,"column":1,"stmt":"LetsStmt"}
+{"time":"2020-01-02T03:04:05Z","event":"var_write","line":5,"column":1,"name":"m","scope":"global","value":{"a":1,"b":[1.5,{"c":null}]}}
+{"time":"2020-01-02T03:04:05Z","event":"stmt_enter","line":6,"column":1,"stmt":"ExprStmt"}
+{"time":"2020-01-02T03:04:05Z","event":"go_call","line":6,"column":1,"name":"loginContext","args":["admin","[REDACTED]"],"results":[true]}
+`
+	if buffer.String() != expected {
+		t.Errorf("JSONTracer - received:\n%v\nexpected:\n%v", buffer.String(), expected)
+	}
+}
+
+type errorWriter struct {
+	writes int
+}
+
+func (writer *errorWriter) Write(p []byte) (int, error) {
+	writer.writes++
+	return 0, errors.New("write error")
+}
+
+func TestJSONTracerWriteError(t *testing.T) {
+	stmt, err := parser.ParseSrc("a = 1\nb = 2")
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	writer := &errorWriter{}
+	tracer := NewJSONTracer(writer)
+	_, err = vm.Run(env.NewEnv(), &vm.Options{Tracer: tracer}, stmt)
+	if err != nil {
+		t.Fatal("Run error:", err)
+	}
+	if tracer.Err() == nil || !strings.Contains(tracer.Err().Error(), "write error") {
+		t.Errorf("Err - received: %v - expected: %v", tracer.Err(), "write error")
+	}
+	if writer.writes != 1 {
+		t.Errorf("writes - received: %v - expected: %v", writer.writes, 1)
+	}
+}
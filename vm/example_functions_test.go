@@ -1,6 +1,7 @@
 package vm_test
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -182,3 +183,34 @@ println(a)
 	// 3
 
 }
+
+func ExampleBind() {
+	e := env.NewEnv()
+
+	script := `
+func greet(name) {
+	return "hello " + name
+}
+`
+
+	_, err := vm.Execute(e, nil, script)
+	if err != nil {
+		log.Fatalf("execute error: %v\n", err)
+	}
+
+	var greet func(ctx context.Context, name string) (string, error)
+	err = vm.Bind(e, "greet", &greet)
+	if err != nil {
+		log.Fatalf("bind error: %v\n", err)
+	}
+
+	greeting, err := greet(context.Background(), "anko")
+	if err != nil {
+		log.Fatalf("greet error: %v\n", err)
+	}
+	fmt.Println(greeting)
+
+	// output:
+	// hello anko
+
+}
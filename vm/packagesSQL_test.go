@@ -0,0 +1,146 @@
+package vm
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mattn/anko/packages"
+)
+
+// testSQLDriver is an in-process driver of a table people with the columns name and age.
+// It knows the statements:
+// insert into people values (?, ?)
+// select name, age from people
+// select name, age from people where age >= ?
+type testSQLDriver struct {
+	mutex  sync.Mutex
+	people [][]driver.Value
+	closed int
+}
+
+type testSQLConn struct {
+	driver *testSQLDriver
+}
+
+type testSQLStmt struct {
+	conn  *testSQLConn
+	query string
+}
+
+type testSQLRows struct {
+	driver *testSQLDriver
+	rows   [][]driver.Value
+	index  int
+}
+
+func (sqlDriver *testSQLDriver) Open(name string) (driver.Conn, error) {
+	if name != "test" {
+		return nil, fmt.Errorf("unknown database %v", name)
+	}
+	return &testSQLConn{driver: sqlDriver}, nil
+}
+
+func (conn *testSQLConn) Prepare(query string) (driver.Stmt, error) {
+	return &testSQLStmt{conn: conn, query: query}, nil
+}
+
+func (conn *testSQLConn) Close() error { return nil }
+
+func (conn *testSQLConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("transactions not supported")
+}
+
+func (stmt *testSQLStmt) Close() error { return nil }
+
+func (stmt *testSQLStmt) NumInput() int { return strings.Count(stmt.query, "?") }
+
+func (stmt *testSQLStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if stmt.query != "insert into people values (?, ?)" {
+		return nil, fmt.Errorf("unknown statement %v", stmt.query)
+	}
+	sqlDriver := stmt.conn.driver
+	sqlDriver.mutex.Lock()
+	sqlDriver.people = append(sqlDriver.people, []driver.Value{args[0], args[1]})
+	sqlDriver.mutex.Unlock()
+	return driver.RowsAffected(1), nil
+}
+
+func (stmt *testSQLStmt) Query(args []driver.Value) (driver.Rows, error) {
+	sqlDriver := stmt.conn.driver
+	sqlDriver.mutex.Lock()
+	defer sqlDriver.mutex.Unlock()
+	rows := &testSQLRows{driver: sqlDriver}
+	switch stmt.query {
+	case "select name, age from people":
+		rows.rows = append(rows.rows, sqlDriver.people...)
+	case "select name, age from people where age >= ?":
+		age, ok := args[0].(int64)
+		if !ok {
+			return nil, fmt.Errorf("age is type %T", args[0])
+		}
+		for _, person := range sqlDriver.people {
+			if person[1].(int64) >= age {
+				rows.rows = append(rows.rows, person)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown query %v", stmt.query)
+	}
+	return rows, nil
+}
+
+func (rows *testSQLRows) Columns() []string { return []string{"name", "age"} }
+
+func (rows *testSQLRows) Close() error {
+	rows.driver.mutex.Lock()
+	rows.driver.closed++
+	rows.driver.mutex.Unlock()
+	return nil
+}
+
+func (rows *testSQLRows) Next(dest []driver.Value) error {
+	if rows.index >= len(rows.rows) {
+		return io.EOF
+	}
+	copy(dest, rows.rows[rows.index])
+	rows.index++
+	return nil
+}
+
+func TestPackagesSQL(t *testing.T) {
+	testDriver := &testSQLDriver{people: [][]driver.Value{{"a", int64(1)}, {"b", int64(2)}}}
+	packages.RegisterSQLDriver("anko-test", testDriver)
+	defer packages.UnregisterSQLDriver("anko-test")
+
+	tests := []Test{
+		{Script: `sql = import("database/sql"); sql.Drivers()`, RunOutput: []string{"anko-test"}},
+		{Script: `sql = import("database/sql"); sql.Register`, RunError: fmt.Errorf("undefined symbol 'Register'")},
+		{Script: `sql = import("database/sql"); a, err = sql.Open("sqlite3", "test"); err`, RunOutput: fmt.Errorf(`sql: driver "sqlite3" is not registered`)},
+		{Script: `sql = import("database/sql"); a, err = sql.Open("anko-test", "other"); err = a.Ping(); err`, RunOutput: fmt.Errorf("unknown database other")},
+		{Script: `sql = import("database/sql"); a, err = sql.Open("anko-test", "test"); if err != nil { return err }; b, err = a.Query("select name, age from people"); if err != nil { return err }; c = []; for d in b { c += d }; c`,
+			RunOutput: []interface{}{map[string]interface{}{"name": "a", "age": int64(1)}, map[string]interface{}{"name": "b", "age": int64(2)}}},
+		{Script: `sql = import("database/sql"); a, err = sql.Open("anko-test", "test"); if err != nil { return err }; b, err = a.Query("select name, age from people where age >= ?", [2]...); if err != nil { return err }; c = []; for d in b { c += d.name }; c`,
+			RunOutput: []interface{}{"b"}},
+		{Script: `sql = import("database/sql"); a, err = sql.Open("anko-test", "test"); if err != nil { return err }; b, err = a.Exec("insert into people values (?, ?)", ["c", 3]...); if err != nil { return err }; c, err = b.RowsAffected(); c`,
+			RunOutput: int64(1)},
+		{Script: `sql = import("database/sql"); a, err = sql.Open("anko-test", "test"); if err != nil { return err }; b, err = a.Query("select name, age from people where age >= ?", 3); if err != nil { return err }; for c in b { return c.name }`,
+			RunOutput: "c"},
+		{Script: `sql = import("database/sql"); a, err = sql.Open("anko-test", "test"); if err != nil { return err }; b, err = a.Query("select name, age from people where age >= ?", "a"); err`,
+			RunOutput: fmt.Errorf("age is type string")},
+		{Script: `sql = import("database/sql"); a, err = sql.Open("anko-test", "test"); if err != nil { return err }; b = a.QueryRow("select name, age from people where age >= ?", 2); c = ""; d = 0; err = b.Scan(&c, &d); if err != nil { return err }; [c, d]`,
+			RunOutput: []interface{}{"b", int64(2)}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	testDriver.mutex.Lock()
+	closed := testDriver.closed
+	testDriver.mutex.Unlock()
+	// the rows of the for in loop with return are closed by the loop
+	if closed != 4 {
+		t.Errorf("closed rows - received: %v - expected: %v", closed, 4)
+	}
+}
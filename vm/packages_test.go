@@ -1,10 +1,15 @@
 package vm
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
+	"text/template"
 
+	"github.com/mattn/anko/ast"
 	"github.com/mattn/anko/env"
 	_ "github.com/mattn/anko/packages"
 )
@@ -37,16 +42,188 @@ func TestImport(t *testing.T) {
 	env.Packages = envPackages
 }
 
+func TestPackagesArchive(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `bytes = import("bytes"); tar = import("archive/tar"); a = make(bytes.Buffer); b = tar.NewWriter(&a); tar.WriteEntry(b, "c", "cc"); tar.WriteEntry(b, "d", "d"); b.Close(); e = []; for f in tar.Entries(&a) { g, err = f.ReadAll(); if err != nil { return err }; e += [[f.Name, f.Header.Size, toString(g)]] }; e`,
+			Input: map[string]interface{}{"toString": func(b []byte) string { return string(b) }}, RunOutput: []interface{}{[]interface{}{"c", int64(2), "cc"}, []interface{}{"d", int64(1), "d"}}},
+		{Script: `bytes = import("bytes"); tar = import("archive/tar"); ioutil = import("io/ioutil"); a = make(bytes.Buffer); b = tar.NewWriter(&a); tar.WriteEntry(b, "c", "cc"); b.Close(); for f in tar.Entries(&a) { g, err = ioutil.ReadAll(f); if err != nil { return err }; return g }`,
+			RunOutput: []byte("cc")},
+		{Script: `bytes = import("bytes"); tar = import("archive/tar"); for f in tar.Entries(bytes.NewReader("a")) { }`, RunError: fmt.Errorf("unexpected EOF")},
+		{Script: `bytes = import("bytes"); zip = import("archive/zip"); a = make(bytes.Buffer); b = zip.NewWriter(&a); zip.WriteEntry(b, "c", "cc"); d, err = b.Create("d"); d.Write("d"); b.Close(); e, err = zip.Entries(a.Bytes()); if err != nil { return err }; g = []; for f in e { h, err = f.ReadAll(); if err != nil { return err }; g += [[f.Name, f.Header.UncompressedSize64, toString(h)]] }; g`,
+			Input: map[string]interface{}{"toString": func(b []byte) string { return string(b) }}, RunOutput: []interface{}{[]interface{}{"c", uint64(2), "cc"}, []interface{}{"d", uint64(1), "d"}}},
+		{Script: `zip = import("archive/zip"); a, err = zip.Entries("a"); err`, RunOutput: fmt.Errorf("zip: not a valid zip file")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesBase64(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `base64 = import("encoding/base64"); base64.StdEncoding.EncodeToString("??>")`, RunOutput: "Pz8+"},
+		{Script: `base64 = import("encoding/base64"); base64.URLEncoding.EncodeToString("??>")`, RunOutput: "Pz8-"},
+		{Script: `base64 = import("encoding/base64"); a, err = base64.StdEncoding.DecodeString("Pz8+"); if err != nil { return err }; a`, RunOutput: []byte("??>")},
+		{Script: `base64 = import("encoding/base64"); a, err = base64.URLEncoding.DecodeString("Pz8-"); if err != nil { return err }; a`, RunOutput: []byte("??>")},
+		{Script: `base64 = import("encoding/base64"); a, err = base64.StdEncoding.DecodeString("Pz8-"); err != nil`, RunOutput: true},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesBufio(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `bufio = import("bufio"); strings = import("strings"); a = bufio.NewScanner(strings.NewReader("a\nb\n\nc")); b = []; for c in a { b += c }; b`, RunOutput: []interface{}{"a", "b", "", "c"}},
+		{Script: `bufio = import("bufio"); strings = import("strings"); a = bufio.NewScanner(strings.NewReader("a b  c")); a.Split(bufio.ScanWords); b = []; for c in a { b += c }; b`, RunOutput: []interface{}{"a", "b", "c"}},
+		{Script: `bufio = import("bufio"); strings = import("strings"); a = bufio.NewScanner(strings.NewReader("a\nb\nc")); b = []; for c in a { if c == "b" { continue }; b += c }; b`, RunOutput: []interface{}{"a", "c"}},
+		{Script: `bufio = import("bufio"); strings = import("strings"); a = bufio.NewScanner(strings.NewReader("a\nb\nc")); b = []; for c in a { if c == "b" { break }; b += c }; b`, RunOutput: []interface{}{"a"}},
+		{Script: `bufio = import("bufio"); strings = import("strings"); a = bufio.NewScanner(strings.NewReader("a\nb\nc")); func d() { for c in a { if c == "b" { return c } } }; d()`, RunOutput: "b"},
+		{Script: `bufio = import("bufio"); strings = import("strings"); a = bufio.NewScanner(strings.NewReader("a\nbbbb")); a.Buffer(make([]byte, 2), 2); b = []; for c in a { b += c }`, RunError: fmt.Errorf("bufio.Scanner: token too long")},
+		{Script: `bufio = import("bufio"); strings = import("strings"); a = bufio.NewReader(strings.NewReader("a\nb")); b, err = a.ReadString('\n'); if err != nil { return err }; b`, RunOutput: "a\n"},
+		{Script: `bufio = import("bufio"); bytes = import("bytes"); a = make(bytes.Buffer); b = bufio.NewWriter(&a); b.WriteString("a"); b.Flush(); a.String()`, RunOutput: "a"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestPackagesBytes(t *testing.T) {
 	t.Parallel()
 
 	tests := []Test{
 		{Script: `bytes = import("bytes"); a = make(bytes.Buffer); n, err = a.WriteString("a"); if err != nil { return err }; n`, RunOutput: 1},
 		{Script: `bytes = import("bytes"); a = make(bytes.Buffer); n, err = a.WriteString("a"); if err != nil { return err }; a.String()`, RunOutput: "a"},
+		{Script: `bytes = import("bytes"); fmt = import("fmt"); a = make(bytes.Buffer); fmt.Fprint(&a, "a"); fmt.Fprint(&a, "b"); a.String()`, RunOutput: "ab"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesCompress(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `bytes = import("bytes"); gzip = import("compress/gzip"); ioutil = import("io/ioutil"); a = make(bytes.Buffer); b = gzip.NewWriter(&a); b.Write("abc"); b.Close(); c, err = gzip.NewReader(&a); if err != nil { return err }; d, err = ioutil.ReadAll(c); if err != nil { return err }; d`,
+			RunOutput: []byte("abc")},
+		{Script: `bytes = import("bytes"); zlib = import("compress/zlib"); ioutil = import("io/ioutil"); a = make(bytes.Buffer); b = zlib.NewWriter(&a); b.Write("abc"); b.Close(); c, err = zlib.NewReader(&a); if err != nil { return err }; d, err = ioutil.ReadAll(c); if err != nil { return err }; d`,
+			RunOutput: []byte("abc")},
+		{Script: `bytes = import("bytes"); gzip = import("compress/gzip"); tar = import("archive/tar"); a = make(bytes.Buffer); b = gzip.NewWriter(&a); c = tar.NewWriter(b); tar.WriteEntry(c, "d", "d"); c.Close(); b.Close(); e, err = gzip.NewReader(&a); if err != nil { return err }; f = []; for g in tar.Entries(e) { f += g.Name }; f`,
+			RunOutput: []interface{}{"d"}},
+		{Script: `bytes = import("bytes"); gzip = import("compress/gzip"); a, err = gzip.NewReader(bytes.NewReader("abc")); err`, RunOutput: fmt.Errorf("unexpected EOF")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesContext(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `context = import("context"); a, b = context.WithCancel(context.Background()); b(); a.Err()`, RunOutput: context.Canceled},
+		{Script: `context = import("context"); a, b = context.WithCancel(); b(); a.Err()`, RunOutput: context.Canceled},
+		{Script: `context = import("context"); time = import("time"); a, b = context.WithTimeout(context.Background(), time.Nanosecond); time.Sleep(time.Millisecond); b(); a.Err()`, RunOutput: context.DeadlineExceeded},
+		{Script: `context = import("context"); a = context.WithValue(context.Background(), "a", "b"); a.Value("a")`, RunOutput: "b"},
+		{Script: `context = import("context"); a = context.WithValue(context.Background(), "a", "b"); a.Value("c")`, RunOutput: nil},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesCrypto(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `md5 = import("crypto/md5"); md5.SumHex("abc")`, RunOutput: "900150983cd24fb0d6963f7d28e17f72"},
+		{Script: `sha1 = import("crypto/sha1"); sha1.SumHex("abc")`, RunOutput: "a9993e364706816aba3e25717850c26c9cd0d89d"},
+		{Script: `sha256 = import("crypto/sha256"); sha256.Sum224Hex("abc")`, RunOutput: "23097d223405d8228642a477bda255b32aadbce4bda0b3f7e36c9da7"},
+		{Script: `sha256 = import("crypto/sha256"); sha256.Sum256Hex("abc")`, RunOutput: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{Script: `sha512 = import("crypto/sha512"); sha512.Sum384Hex("abc")`, RunOutput: "cb00753f45a35e8bb5a03d699ac65007272c32ab0eded1631a8b605a43ff5bed8086072ba1e7cc2358baeca134c825a7"},
+		{Script: `sha512 = import("crypto/sha512"); sha512.Sum512Hex("abc")`, RunOutput: "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f"},
+		{Script: `sha256 = import("crypto/sha256"); a = sha256.New(); a.Write("abc"); len(a.Sum(nil))`, RunOutput: int64(32)},
+		{Script: `sha256 = import("crypto/sha256"); hmac = import("crypto/hmac"); hmac.SumHex(sha256.New, "key", "The quick brown fox jumps over the lazy dog")`,
+			RunOutput: "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"},
+		{Script: `sha256 = import("crypto/sha256"); hmac = import("crypto/hmac"); a = hmac.New(sha256.New, "key"); a.Write("abc"); b = hmac.New(sha256.New, "key"); b.Write("abc"); hmac.Equal(a.Sum(nil), b.Sum(nil))`, RunOutput: true},
+		{Script: `rand = import("crypto/rand"); a = make([]byte, 8); n, err = rand.Read(a); if err != nil { return err }; n`, RunOutput: 8},
+		{Script: `subtle = import("crypto/subtle"); subtle.ConstantTimeCompare("abc", "abc")`, RunOutput: 1},
+		{Script: `subtle = import("crypto/subtle"); subtle.ConstantTimeCompare("abc", "abd")`, RunOutput: 0},
 	}
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestPackagesCsv(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `csv = import("encoding/csv"); a, err = csv.ReadAllString("a,b\n1,\"2,3\"\n"); if err != nil { return err }; a`, RunOutput: [][]string{{"a", "b"}, {"1", "2,3"}}},
+		{Script: `csv = import("encoding/csv"); a, err = csv.ReadAllString("a,b\n1\n"); err != nil`, RunOutput: true},
+		{Script: `csv = import("encoding/csv"); a, err = csv.WriteAllString([["a", "b"], [1, "2,3"]]); if err != nil { return err }; a`, RunOutput: "a,b\n1,\"2,3\"\n"},
+		{Script: `csv = import("encoding/csv"); a, err = csv.WriteAllString([1]); err`, RunOutput: fmt.Errorf("row 0 is type int64 and not a slice of cells")},
+		{Script: `csv = import("encoding/csv"); a, err = csv.WriteAllString(1); err`, RunOutput: fmt.Errorf("rows is type int64 and not a slice of rows")},
+		{Script: `csv = import("encoding/csv"); bytes = import("bytes"); a = make(bytes.Buffer); b = csv.NewWriter(&a); b.Write(["a", "b"]); b.Flush(); a.String()`, RunOutput: "a,b\n"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesHash(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `crc32 = import("hash/crc32"); crc32.ChecksumIEEE("abc")`, RunOutput: uint32(0x352441c2)},
+		{Script: `fnv = import("hash/fnv"); a = fnv.New32a(); a.Write("abc"); a.Sum32()`, RunOutput: uint32(0x1a47e90b)},
+		{Script: `fnv = import("hash/fnv"); a = fnv.New64a(); a.Write("abc"); a.Sum64()`, RunOutput: uint64(0xe71fa2190541574b)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesHex(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `hex = import("encoding/hex"); hex.EncodeToString("ab")`, RunOutput: "6162"},
+		{Script: `hex = import("encoding/hex"); a, err = hex.DecodeString("6162"); if err != nil { return err }; a`, RunOutput: []byte("ab")},
+		{Script: `hex = import("encoding/hex"); a, err = hex.DecodeString("zz"); err != nil`, RunOutput: true},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesHTTP(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `http = import("net/http"); httptest = import("net/http/httptest"); a = http.HandlerFunc(func(w, r) { w.Header().Set("X-A", r.URL.Path); w.WriteHeader(201); w.Write("a") }); b = httptest.NewRecorder(); a.ServeHTTP(b, httptest.NewRequest("GET", "/a", nil)); [b.Code, b.Header().Get("X-A"), toString(b.Body.Bytes())]`,
+			Input: map[string]interface{}{"toString": func(b []byte) string { return string(b) }}, RunOutput: []interface{}{201, "/a", "a"}},
+		{Script: `http = import("net/http"); httptest = import("net/http/httptest"); a = http.NewServeMux(); a.HandleFunc("/a", func(w, r) { w.Write("a") }); a.Handle("/b", http.HandlerFunc(func(w, r) { http.Error(w, "b", 400) })); b = httptest.NewRecorder(); a.ServeHTTP(b, httptest.NewRequest("GET", "/b", nil)); [b.Code, toString(b.Body.Bytes())]`,
+			Input: map[string]interface{}{"toString": func(b []byte) string { return string(b) }}, RunOutput: []interface{}{400, "b\n"}},
+		{Script: `http = import("net/http"); a = make(http.Header); a.Add("X-A", "a"); a.Add("X-A", "b"); a["X-A"]`, RunOutput: []string{"a", "b"}},
+		{Script: `http = import("net/http"); a = make([]http.ResponseWriter); a`, RunOutput: []http.ResponseWriter{}},
+		{Script: `http = import("net/http"); httptest = import("net/http/httptest"); ioutil = import("io/ioutil"); a = httptest.NewServer(http.HandlerFunc(func(w, r) { w.Write(r.URL.Query().Get("a")) })); b, err = http.Get(a.URL + "/?a=b"); if err != nil { a.Close(); return err }; c, err = ioutil.ReadAll(b.Body); b.Body.Close(); a.Close(); if err != nil { return err }; [b.StatusCode, toString(c)]`,
+			Input: map[string]interface{}{"toString": func(b []byte) string { return string(b) }}, RunOutput: []interface{}{200, "b"}},
+		{Script: `http = import("net/http"); httptest = import("net/http/httptest"); a = http.HandlerFunc(func(w, r) { throw "a" }); a.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))`, RunError: fmt.Errorf("a")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	// the error of a handler has the stack of the throw, the handler function and the ServeHTTP call
+	script := `
+http = import("net/http")
+httptest = import("net/http/httptest")
+func handle(w, r) {
+	throw "a"
+}
+a = http.HandlerFunc(func(w, r) {
+	handle(w, r)
+})
+a.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+`
+	for _, debug := range []bool{true, false} {
+		_, err := Execute(env.NewEnv(), &Options{Debug: debug}, script)
+		vmErr, ok := err.(*Error)
+		if !ok {
+			t.Fatalf("execute error - received: %#v - expected: *Error", err)
+		}
+		expected := []ast.Position{{Line: 5, Column: 2}, {Line: 8, Column: 2}, {Line: 10, Column: 1}}
+		if !reflect.DeepEqual(vmErr.Stack, expected) {
+			t.Errorf("error stack - received: %v - expected: %v - debug: %v", vmErr.Stack, expected, debug)
+		}
+	}
+}
+
 func TestPackagesJson(t *testing.T) {
 	t.Parallel()
 
@@ -173,6 +350,45 @@ func TestPackagesSync(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestPackagesTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `template = import("text/template"); bytes = import("bytes"); a, err = template.New("a").Parse("{{.b}}{{range .c}}[{{.}}]{{end}}"); if err != nil { return err }; b = make(bytes.Buffer); err = a.Execute(&b, {"b": "b", "c": [1, "c"]}); if err != nil { return err }; b.String()`,
+			RunOutput: "b[1][c]"},
+		{Script: `template = import("text/template"); bytes = import("bytes"); func c(d) { return d + "c" }; a, err = template.New("a").Funcs({"c": c}).Parse("{{c .}}"); if err != nil { return err }; b = make(bytes.Buffer); err = a.Execute(&b, "b"); if err != nil { return err }; b.String()`,
+			RunOutput: "bc"},
+		{Script: `template = import("text/template"); bytes = import("bytes"); c = make(template.FuncMap); c["d"] = func(e...) { return len(e) }; a, err = template.New("a").Funcs(c).Parse("{{d 1 2 3}}"); if err != nil { return err }; b = make(bytes.Buffer); err = a.Execute(&b, nil); if err != nil { return err }; b.String()`,
+			RunOutput: "3"},
+		{Script: `template = import("text/template"); bytes = import("bytes"); a, err = template.New("a").Funcs({"c": func() { throw "c error" }}).Parse("{{c}}"); if err != nil { return err }; b = make(bytes.Buffer); a.Execute(&b, nil)`,
+			RunOutput: fmt.Errorf(`template: a:1:2: executing "a" at <c>: error calling c: c error`)},
+		{Script: `template = import("text/template"); bytes = import("bytes"); a, err = template.New("a").Funcs({"c": func(d) { return d }}).Parse("{{c 1 2}}"); if err != nil { return err }; b = make(bytes.Buffer); a.Execute(&b, nil)`,
+			RunOutput: fmt.Errorf(`template: a:1:2: executing "a" at <c 1 2>: error calling c: function wants 1 arguments but received 2`)},
+		{Script: `template = import("text/template"); a, err = template.New("a").Parse("{{"); err`, RunOutput: fmt.Errorf("template: a:1: unclosed action")},
+		{Script: `template = import("html/template"); bytes = import("bytes"); func c(d) { return d + "c" }; a, err = template.New("a").Funcs({"c": c}).Parse("<p>{{c .}}</p>"); if err != nil { return err }; b = make(bytes.Buffer); err = a.Execute(&b, "<b>"); if err != nil { return err }; b.String()`,
+			RunOutput: "<p>&lt;b&gt;c</p>"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesTemplateAfterRun(t *testing.T) {
+	// the FuncMap functions of a template can be called after the run
+	ctx, cancel := context.WithCancel(context.Background())
+	value, err := ExecuteContext(ctx, env.NewEnv(), nil, `template = import("text/template"); a, err = template.New("a").Funcs({"b": func() { return "b" }}).Parse("{{b}}"); if err != nil { throw err }; a`)
+	cancel()
+	if err != nil {
+		t.Fatal("execute error:", err)
+	}
+	var buffer bytes.Buffer
+	err = value.(*template.Template).Execute(&buffer, nil)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if buffer.String() != "b" {
+		t.Errorf("Execute - received: %v - expected: b", buffer.String())
+	}
+}
+
 func TestPackagesTime(t *testing.T) {
 	t.Parallel()
 
@@ -182,6 +398,19 @@ func TestPackagesTime(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestPackagesUnicode(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `unicode = import("unicode"); unicode.IsLetter('a')`, RunOutput: true},
+		{Script: `unicode = import("unicode"); unicode.IsSpace('a')`, RunOutput: false},
+		{Script: `unicode = import("unicode"); unicode.ToUpper('a')`, RunOutput: 'A'},
+		{Script: `utf8 = import("unicode/utf8"); utf8.RuneCountInString("héllo")`, RunOutput: 5},
+		{Script: `utf8 = import("unicode/utf8"); utf8.ValidString("a")`, RunOutput: true},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestPackagesURL(t *testing.T) {
 	t.Parallel()
 
@@ -207,3 +436,21 @@ v2.Get("a")
 		t.Errorf("execute value - received: %#v expected: %#v", value, "")
 	}
 }
+
+func TestPackagesXml(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `xml = import("encoding/xml"); a, err = xml.UnmarshalMap("<a b=\"1\"><c>x</c><c>y</c><d>z</d></a>"); if err != nil { return err }; a`,
+			RunOutput: map[string]interface{}{"a": map[string]interface{}{"@b": "1", "c": []interface{}{"x", "y"}, "d": "z"}}},
+		{Script: `xml = import("encoding/xml"); a, err = xml.UnmarshalMap("<a b=\"1\"> x </a>"); if err != nil { return err }; a`,
+			RunOutput: map[string]interface{}{"a": map[string]interface{}{"@b": "1", "#text": "x"}}},
+		{Script: `xml = import("encoding/xml"); a, err = xml.UnmarshalMap("<a><b></a>"); err != nil`, RunOutput: true},
+		{Script: `xml = import("encoding/xml"); a, err = xml.MarshalMap({"a": {"@b": 1, "#text": "x<", "d": "z", "c": ["x", "y"], "e": nil}}); if err != nil { return err }; a`,
+			RunOutput: []byte(`<a b="1">x&lt;<c>x</c><c>y</c><d>z</d><e></e></a>`)},
+		{Script: `xml = import("encoding/xml"); a, err = xml.MarshalMap(1); err`, RunOutput: fmt.Errorf("value is type int64 and not a map")},
+		{Script: `xml = import("encoding/xml"); a, err = xml.UnmarshalMap("<a b=\"1\"><c>x</c><c>y</c></a>"); if err != nil { return err }; a, err = xml.MarshalMap(a); if err != nil { return err }; a`,
+			RunOutput: []byte(`<a b="1"><c>x</c><c>y</c></a>`)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
@@ -12,7 +12,10 @@ import (
 
 // Options provides options to run VM with
 type Options struct {
-	Debug bool // run in Debug mode
+	Debug    bool      // run in Debug mode
+	Profiler *Profiler // profile the run if not nil
+	Coverage *Coverage // count statements and branches of the run if not nil
+	Tracer   Tracer    // send the events of the run if not nil
 }
 
 type (
@@ -20,6 +23,15 @@ type (
 	Error struct {
 		Message string
 		Pos     ast.Position
+		// Stack has the position the error was thrown at and the positions of the calls it returned through,
+		// innermost first, when the error was thrown in a script function
+		Stack []ast.Position
+	}
+
+	// functionError is the panic of the error of a script function converted to a Go function,
+	// so the call of the Go function can tell it from other panics
+	functionError struct {
+		err error
 	}
 
 	// runInfo provides run incoming and outgoing information
@@ -36,6 +48,42 @@ type (
 		rv  reflect.Value
 		err error
 	}
+
+	// iterator is a value that for in loops over the values of, calling Next before each Value
+	iterator interface {
+		Next() bool
+		Value() interface{}
+		Err() error
+	}
+
+	// textScanner is a scanner that for in loops over the scanned texts of, like *bufio.Scanner
+	textScanner interface {
+		Scan() bool
+		Text() string
+		Err() error
+	}
+
+	// textScannerIterator is the iterator of a textScanner
+	textScannerIterator struct {
+		textScanner
+	}
+
+	// rowsScanner is rows that for in loops over as maps of column name to value, like *sql.Rows
+	rowsScanner interface {
+		Next() bool
+		Columns() ([]string, error)
+		Scan(dest ...interface{}) error
+		Err() error
+		Close() error
+	}
+
+	// rowsScannerIterator is the iterator of a rowsScanner
+	rowsScannerIterator struct {
+		rowsScanner
+		columns []string
+		row     map[string]interface{}
+		err     error
+	}
 )
 
 var (
@@ -49,6 +97,7 @@ var (
 	errorType          = reflect.ValueOf([]error{nil}).Index(0).Type()
 	vmErrorType        = reflect.TypeOf(&Error{})
 	contextType        = reflect.TypeOf((*context.Context)(nil)).Elem()
+	funcMapFuncType    = reflect.TypeOf((func(...interface{}) interface{})(nil))
 
 	nilValue                  = reflect.New(reflect.TypeOf((*interface{})(nil)).Elem()).Elem()
 	trueValue                 = reflect.ValueOf(true)
@@ -74,15 +123,45 @@ func (e *Error) Error() string {
 	return e.Message
 }
 
-// newError makes VM error from error
+// Error returns the error message of the script function.
+func (e *functionError) Error() string {
+	return e.err.Error()
+}
+
+// newError makes VM error from error, keeping the stack of a VM error
 func newError(pos ast.Pos, err error) error {
 	if err == nil {
 		return nil
 	}
+	var stack []ast.Position
+	if vmErr, ok := err.(*Error); ok {
+		stack = vmErr.Stack
+	}
 	if pos == nil {
-		return &Error{Message: err.Error(), Pos: ast.Position{Line: 1, Column: 1}}
+		return &Error{Message: err.Error(), Pos: ast.Position{Line: 1, Column: 1}, Stack: stack}
+	}
+	return &Error{Message: err.Error(), Pos: pos.Position(), Stack: stack}
+}
+
+// newFunctionError makes the VM error of a script function from the error of its statements.
+// The stack starts with the position the error was thrown at.
+func newFunctionError(funcExpr *ast.FuncExpr, err error) error {
+	vmErr, ok := err.(*Error)
+	if ok && len(vmErr.Stack) == 0 {
+		err = &Error{Message: vmErr.Message, Pos: vmErr.Pos, Stack: []ast.Position{vmErr.Pos}}
+	}
+	return newError(funcExpr, err)
+}
+
+// addCallStack adds the position of the call to the stack of a VM error thrown in a script function
+func (runInfo *runInfoStruct) addCallStack(callExpr *ast.CallExpr) {
+	vmErr, ok := runInfo.err.(*Error)
+	if !ok || len(vmErr.Stack) == 0 {
+		return
 	}
-	return &Error{Message: err.Error(), Pos: pos.Position()}
+	stack := make([]ast.Position, len(vmErr.Stack), len(vmErr.Stack)+1)
+	copy(stack, vmErr.Stack)
+	runInfo.err = &Error{Message: vmErr.Message, Pos: vmErr.Pos, Stack: append(stack, callExpr.Position())}
 }
 
 // newStringError makes VM error from string
@@ -96,19 +175,103 @@ func newStringError(pos ast.Pos, err string) error {
 	return &Error{Message: err, Pos: pos.Position()}
 }
 
+// Next scans the next text
+func (iter textScannerIterator) Next() bool {
+	return iter.Scan()
+}
+
+// Value returns the scanned text
+func (iter textScannerIterator) Value() interface{} {
+	return iter.Text()
+}
+
+// Next scans the next row
+func (iter *rowsScannerIterator) Next() bool {
+	if iter.err != nil || !iter.rowsScanner.Next() {
+		return false
+	}
+	if iter.columns == nil {
+		iter.columns, iter.err = iter.Columns()
+		if iter.err != nil {
+			return false
+		}
+	}
+
+	values := make([]interface{}, len(iter.columns))
+	dest := make([]interface{}, len(values))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	iter.err = iter.Scan(dest...)
+	if iter.err != nil {
+		return false
+	}
+
+	iter.row = make(map[string]interface{}, len(values))
+	for i, column := range iter.columns {
+		iter.row[column] = values[i]
+	}
+	return true
+}
+
+// Value returns the scanned row as a map of column name to value
+func (iter *rowsScannerIterator) Value() interface{} {
+	return iter.row
+}
+
+// Err returns the error of scanning or of the rows
+func (iter *rowsScannerIterator) Err() error {
+	if iter.err != nil {
+		return iter.err
+	}
+	return iter.rowsScanner.Err()
+}
+
 // recoverFunc generic recover function
 func recoverFunc(runInfo *runInfoStruct) {
 	recoverInterface := recover()
 	if recoverInterface == nil {
 		return
 	}
+	runInfo.err = recoverError(recoverInterface)
+}
+
+// recoverCallFunc is recoverFunc for a function call, it adds the call to the stack of the error of a script function
+func recoverCallFunc(runInfo *runInfoStruct, callExpr *ast.CallExpr) {
+	recoverInterface := recover()
+	if recoverInterface == nil {
+		return
+	}
+	runInfo.err = recoverError(recoverInterface)
+	runInfo.addCallStack(callExpr)
+}
+
+// recoverFunctionError is for Debug mode, it captures the panic of the error of a script function
+// called by a Go function and panics again for other panics
+func recoverFunctionError(runInfo *runInfoStruct, callExpr *ast.CallExpr) {
+	recoverInterface := recover()
+	if recoverInterface == nil {
+		return
+	}
+	funcErr, ok := recoverInterface.(*functionError)
+	if !ok {
+		panic(recoverInterface)
+	}
+	runInfo.err = funcErr.err
+	runInfo.addCallStack(callExpr)
+}
+
+// recoverError returns the error of the recovered panic value
+func recoverError(recoverInterface interface{}) error {
 	switch value := recoverInterface.(type) {
+	case *functionError:
+		return value.err
 	case *Error:
-		runInfo.err = value
+		return value
 	case error:
-		runInfo.err = value
+		return value
 	default:
-		runInfo.err = fmt.Errorf("%v", recoverInterface)
+		return fmt.Errorf("%v", recoverInterface)
 	}
 }
 
@@ -197,13 +360,13 @@ func equal(lhsV, rhsV reflect.Value) bool {
 	return reflect.DeepEqual(lhsV.Interface(), rhsV.Interface())
 }
 
-func getMapIndex(key reflect.Value, aMap reflect.Value) reflect.Value {
+func getMapIndex(ctx context.Context, key reflect.Value, aMap reflect.Value) reflect.Value {
 	if aMap.IsNil() {
 		return nilValue
 	}
 
 	var err error
-	key, err = convertReflectValueToType(key, aMap.Type().Key())
+	key, err = convertReflectValueToType(ctx, key, aMap.Type().Key())
 	if err != nil {
 		return nilValue
 	}
@@ -0,0 +1,58 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+// FuncOf returns the function symbol from env converted to a Go function of type funcType.
+// Arguments and return values are converted the same way as when a script function is passed to a Go function.
+// If the first parameter of funcType is context.Context, it is used as the context of the script function run.
+// If the last return value of funcType is error, it returns the script function run error.
+// Otherwise a script function run error will panic.
+func FuncOf(e *env.Env, symbol string, funcType reflect.Type) (reflect.Value, error) {
+	if funcType == nil || funcType.Kind() != reflect.Func {
+		return nilValue, fmt.Errorf("type %v is not a function type", funcType)
+	}
+
+	rv, err := e.GetValue(symbol)
+	if err != nil {
+		return nilValue, err
+	}
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Func {
+		return nilValue, fmt.Errorf("symbol '%s' is type %v and not a function", symbol, rv.Kind())
+	}
+
+	if rv.Type() == funcType {
+		// Go function with same type
+		return rv, nil
+	}
+	if !checkIfRunVMFunction(rv.Type()) {
+		return nilValue, fmt.Errorf("function '%s' of type %v cannot be converted to type %v", symbol, rv.Type(), funcType)
+	}
+
+	return makeVMConvertFunction(context.Background(), rv, funcType, true)
+}
+
+// Bind sets the Go function pointed to by funcPtr to call the function symbol from env.
+// funcPtr must be a pointer to a function variable, see FuncOf for how the function is converted.
+func Bind(e *env.Env, symbol string, funcPtr interface{}) error {
+	ptrV := reflect.ValueOf(funcPtr)
+	if ptrV.Kind() != reflect.Ptr || ptrV.IsNil() || ptrV.Elem().Kind() != reflect.Func {
+		return fmt.Errorf("funcPtr must be pointer to function but received type %T", funcPtr)
+	}
+
+	rv, err := FuncOf(e, symbol, ptrV.Elem().Type())
+	if err != nil {
+		return err
+	}
+
+	ptrV.Elem().Set(rv)
+	return nil
+}
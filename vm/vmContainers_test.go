@@ -1776,6 +1776,99 @@ func TestStructs(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+type (
+	testMembersUser struct {
+		UserID   int64  `anko:"user_id"`
+		Name     string `anko:"name,readonly"`
+		Password string `anko:"-"`
+		Email    string
+	}
+	testMembersAccount struct {
+		*testMembersUser
+		Balance float64
+	}
+	testMembersURL struct {
+		URLPath string
+		ID      int64
+		Hidden  bool
+	}
+)
+
+func (user *testMembersUser) DisplayName() string { return user.Name + " <" + user.Email + ">" }
+
+func (testMembersURL) HostName() string { return "localhost" }
+
+func (testMembersURL) Internal() string { return "internal" }
+
+func TestStructTags(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `a.user_id`, Input: map[string]interface{}{"a": testMembersUser{UserID: 1}}, RunOutput: int64(1)},
+		{Script: `a.UserID`, Input: map[string]interface{}{"a": testMembersUser{UserID: 1}}, RunError: fmt.Errorf("no member named 'UserID' for struct")},
+		{Script: `a.name`, Input: map[string]interface{}{"a": testMembersUser{Name: "a"}}, RunOutput: "a"},
+		{Script: `a.Password`, Input: map[string]interface{}{"a": testMembersUser{Password: "a"}}, RunError: fmt.Errorf("no member named 'Password' for struct")},
+		{Script: `a.Email`, Input: map[string]interface{}{"a": testMembersUser{Email: "a"}}, RunOutput: "a"},
+		{Script: `a.DisplayName()`, Input: map[string]interface{}{"a": &testMembersUser{Name: "a", Email: "b"}}, RunOutput: "a <b>"},
+
+		{Script: `a.user_id = 2; a.user_id`, Input: map[string]interface{}{"a": &testMembersUser{UserID: 1}}, RunOutput: int64(2), Output: map[string]interface{}{"a": &testMembersUser{UserID: 2}}},
+		{Script: `a.Email = "b"`, Input: map[string]interface{}{"a": &testMembersUser{Email: "a"}}, RunOutput: "b", Output: map[string]interface{}{"a": &testMembersUser{Email: "b"}}},
+		{Script: `a.name = "b"`, Input: map[string]interface{}{"a": &testMembersUser{Name: "a"}}, RunError: fmt.Errorf("struct member 'name' is read-only"), Output: map[string]interface{}{"a": &testMembersUser{Name: "a"}}},
+		{Script: `a.Password = "b"`, Input: map[string]interface{}{"a": &testMembersUser{Password: "a"}}, RunError: fmt.Errorf("no member named 'Password' for struct"), Output: map[string]interface{}{"a": &testMembersUser{Password: "a"}}},
+		{Script: `a.user_id = "b"`, Input: map[string]interface{}{"a": &testMembersUser{}}, RunError: fmt.Errorf("type string cannot be assigned to type int64 for struct"), Output: map[string]interface{}{"a": &testMembersUser{}}},
+
+		{Script: `a.user_id`, Input: map[string]interface{}{"a": &testMembersAccount{testMembersUser: &testMembersUser{UserID: 1}}}, RunOutput: int64(1)},
+		{Script: `a.user_id`, Input: map[string]interface{}{"a": &testMembersAccount{}}, RunError: fmt.Errorf("struct member 'user_id' is in a nil embedded struct")},
+		{Script: `a.user_id = 1`, Input: map[string]interface{}{"a": &testMembersAccount{}}, RunError: fmt.Errorf("struct member 'user_id' cannot be assigned"), Output: map[string]interface{}{"a": &testMembersAccount{}}},
+		{Script: `a.user_id = 2`, Input: map[string]interface{}{"a": &testMembersAccount{testMembersUser: &testMembersUser{UserID: 1}}}, RunOutput: int64(2), Output: map[string]interface{}{"a": &testMembersAccount{testMembersUser: &testMembersUser{UserID: 2}}}},
+		{Script: `a.Balance = 1.5; a.Balance`, Input: map[string]interface{}{"a": &testMembersAccount{}}, RunOutput: float64(1.5)},
+		{Script: `a.DisplayName()`, Input: map[string]interface{}{"a": &testMembersAccount{testMembersUser: &testMembersUser{Name: "a", Email: "b"}}}, RunOutput: "a <b>"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+// TestRegisterMembers is not parallel as it changes the member registry of all tests
+func TestRegisterMembers(t *testing.T) {
+	RegisterMembers(reflect.TypeOf(&testMembersURL{}), &MemberOptions{
+		Names:     map[string]string{"Hidden": "-", "Internal": "-", "HostName": "Host"},
+		ReadOnly:  []string{"ID"},
+		LowerCase: true,
+	})
+	defer RegisterMembers(reflect.TypeOf(testMembersURL{}), nil)
+
+	tests := []Test{
+		{Script: `a.URLPath`, Input: map[string]interface{}{"a": testMembersURL{URLPath: "a"}}, RunOutput: "a"},
+		{Script: `a.urlPath`, Input: map[string]interface{}{"a": testMembersURL{URLPath: "a"}}, RunOutput: "a"},
+		{Script: `a.id`, Input: map[string]interface{}{"a": testMembersURL{ID: 1}}, RunOutput: int64(1)},
+		{Script: `a.Hidden`, Input: map[string]interface{}{"a": testMembersURL{}}, RunError: fmt.Errorf("no member named 'Hidden' for struct")},
+		{Script: `a.hidden`, Input: map[string]interface{}{"a": testMembersURL{}}, RunError: fmt.Errorf("no member named 'hidden' for struct")},
+		{Script: `a.host()`, Input: map[string]interface{}{"a": testMembersURL{}}, RunOutput: "localhost"},
+		{Script: `a.HostName()`, Input: map[string]interface{}{"a": testMembersURL{}}, RunError: fmt.Errorf("no member named 'HostName' for struct")},
+		{Script: `a.internal()`, Input: map[string]interface{}{"a": testMembersURL{}}, RunError: fmt.Errorf("no member named 'internal' for struct")},
+		{Script: `a.urlPath = "b"`, Input: map[string]interface{}{"a": &testMembersURL{URLPath: "a"}}, RunOutput: "b", Output: map[string]interface{}{"a": &testMembersURL{URLPath: "b"}}},
+		{Script: `a.id = 2`, Input: map[string]interface{}{"a": &testMembersURL{ID: 1}}, RunError: fmt.Errorf("struct member 'id' is read-only"), Output: map[string]interface{}{"a": &testMembersURL{ID: 1}}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	tests = []Test{
+		{Script: `a.urlPath`, Input: map[string]interface{}{"a": testMembersURL{URLPath: "a"}}, RunError: fmt.Errorf("no member named 'urlPath' for struct")},
+		{Script: `a.Hidden`, Input: map[string]interface{}{"a": testMembersURL{Hidden: true}}, RunOutput: true},
+	}
+	RegisterMembers(reflect.TypeOf(testMembersURL{}), nil)
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestLowerName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{"": "", "a": "a", "A": "a", "ID": "id", "UserID": "userID", "URLPath": "urlPath", "Path": "path", "HTTPS": "https"}
+	for name, expected := range tests {
+		if value := lowerName(name); value != expected {
+			t.Errorf("lowerName %v - received: %v - expected: %v", name, value, expected)
+		}
+	}
+}
+
 func TestMakeStructs(t *testing.T) {
 	t.Parallel()
 
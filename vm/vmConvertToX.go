@@ -2,8 +2,11 @@ package vm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+
+	"github.com/mattn/anko/env"
 )
 
 // reflectValueSlicetoInterfaceSlice convert from a slice of reflect.Value to a interface slice
@@ -25,7 +28,7 @@ func reflectValueSlicetoInterfaceSlice(valueSlice []reflect.Value) reflect.Value
 
 // convertReflectValueToType trys to covert the reflect.Value to the reflect.Type
 // if it can not, it returns the original rv and an error
-func convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+func convertReflectValueToType(ctx context.Context, rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
 	if rt == interfaceType || rv.Type() == rt {
 		// if reflect.Type is interface or the types match, return the provided reflect.Value
 		return rv, nil
@@ -37,20 +40,20 @@ func convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value
 	if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) &&
 		(rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array) {
 		// covert slice or array
-		return convertSliceOrArray(rv, rt)
+		return convertSliceOrArray(ctx, rv, rt)
 	}
 	if rv.Kind() == rt.Kind() {
 		// kind matches
 		switch rv.Kind() {
 		case reflect.Map:
 			// convert map
-			return convertMap(rv, rt)
+			return convertMap(ctx, rv, rt)
 		case reflect.Func:
 			// for runVMFunction conversions, call convertVMFunctionToType
-			return convertVMFunctionToType(rv, rt)
+			return convertVMFunctionToType(ctx, rv, rt)
 		case reflect.Ptr:
 			// both rv and rt are pointers, convert what they are pointing to
-			value, err := convertReflectValueToType(rv.Elem(), rt.Elem())
+			value, err := convertReflectValueToType(ctx, rv.Elem(), rt.Elem())
 			if err != nil {
 				return rv, err
 			}
@@ -70,7 +73,7 @@ func convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value
 			return reflect.Zero(rt), nil
 		}
 		// try to convert the element
-		return convertReflectValueToType(rv.Elem(), rt)
+		return convertReflectValueToType(ctx, rv.Elem(), rt)
 	}
 
 	if rv.Type() == stringType {
@@ -102,7 +105,7 @@ func convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value
 }
 
 // convertSliceOrArray trys to covert the reflect.Value slice or array to the slice or array reflect.Type
-func convertSliceOrArray(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+func convertSliceOrArray(ctx context.Context, rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
 	rtElemType := rt.Elem()
 
 	// try to covert elements to new slice/array
@@ -118,7 +121,7 @@ func convertSliceOrArray(rv reflect.Value, rt reflect.Type) (reflect.Value, erro
 	var err error
 	var v reflect.Value
 	for i := 0; i < rv.Len(); i++ {
-		v, err = convertReflectValueToType(rv.Index(i), rtElemType)
+		v, err = convertReflectValueToType(ctx, rv.Index(i), rtElemType)
 		if err != nil {
 			return rv, err
 		}
@@ -129,30 +132,102 @@ func convertSliceOrArray(rv reflect.Value, rt reflect.Type) (reflect.Value, erro
 	return value, nil
 }
 
+// convertMapValueToType trys to covert the reflect.Value to the value type of the map reflect.Type.
+// A runVMFunction put in a map of a type in env.FuncMapTypes, like template.FuncMap,
+// is converted to a func(...interface{}) interface{} so the Go code using the map can call it.
+// As the map can be used after the run, like a template executed later, the function does not run with
+// the run context ctx but with a background context, like the functions of FuncOf.
+func convertMapValueToType(ctx context.Context, rv reflect.Value, mapType reflect.Type) (reflect.Value, error) {
+	if _, ok := env.FuncMapTypes[mapType]; ok {
+		value := rv
+		if value.Kind() == reflect.Interface && !value.IsNil() {
+			value = value.Elem()
+		}
+		if value.Kind() == reflect.Func && checkIfRunVMFunction(value.Type()) {
+			return convertVMFunctionToType(context.Background(), value, funcMapFuncType)
+		}
+	}
+	return convertReflectValueToType(ctx, rv, mapType.Elem())
+}
+
 // convertVMFunctionToType is for translating a runVMFunction into the correct type
 // so it can be passed to a Go function argument with the correct static types
 // it creates a translate function runVMConvertFunction
-func convertVMFunctionToType(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+func convertVMFunctionToType(ctx context.Context, rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	return makeVMConvertFunction(ctx, rv, rt, false)
+}
+
+// makeVMConvertFunction creates a function of reflect.Type rt that calls the runVMFunction rv with the run context ctx.
+// If bind is true, a first parameter of type context.Context is used as the run context
+// and a last return value of type error gets the run error instead of a panic.
+func makeVMConvertFunction(ctx context.Context, rv reflect.Value, rt reflect.Type, bind bool) (reflect.Value, error) {
 	// only translates runVMFunction type
 	if !checkIfRunVMFunction(rv.Type()) {
 		return rv, errInvalidTypeConversion
 	}
 
+	hasContext := bind && rt.NumIn() > 0 && rt.In(0) == contextType
+	hasError := bind && rt.NumOut() > 0 && rt.Out(rt.NumOut()-1) == errorType
+	numIn := rt.NumIn()
+	indexIn := 0
+	if hasContext {
+		numIn--
+		indexIn++
+	}
+	numOut := rt.NumOut()
+	if hasError {
+		numOut--
+	}
+
 	// create runVMConvertFunction to match reflect.Type
 	// this function is being called by the Go function
 	runVMConvertFunction := func(in []reflect.Value) []reflect.Value {
 		// note: this function is being called by another reflect Call
-		// only way to pass along any errors is by panic
+		// only way to pass along any errors is by panic, unless there is an error return value
+
+		// returnError returns the zero values and the error when there is an error return value
+		returnError := func(err error) []reflect.Value {
+			if !hasError {
+				if bind {
+					panic(err)
+				}
+				// the call of the Go function recovers the error
+				panic(&functionError{err: err})
+			}
+			rvs := make([]reflect.Value, rt.NumOut())
+			for i := 0; i < numOut; i++ {
+				rvs[i] = reflect.Zero(rt.Out(i))
+			}
+			rvs[numOut] = reflect.ValueOf(&err).Elem()
+			return rvs
+		}
 
 		// make the reflect.Value slice of each of the VM reflect.Value
-		args := make([]reflect.Value, 0, rt.NumIn()+1)
+		args := make([]reflect.Value, 0, numIn+1)
 		// for runVMFunction first arg is always context
-		// TOFIX: use normal context
-		args = append(args, reflect.ValueOf(context.Background()))
-		for i := 0; i < rt.NumIn(); i++ {
+		runCtx := ctx
+		if hasContext && !in[0].IsNil() {
+			runCtx = in[0].Interface().(context.Context)
+		}
+		args = append(args, reflect.ValueOf(runCtx))
+		for i := indexIn; i < rt.NumIn(); i++ {
+			if rt.IsVariadic() && i == rt.NumIn()-1 {
+				// pass each variadic value as an argument
+				for j := 0; j < in[i].Len(); j++ {
+					arg := in[i].Index(j)
+					if arg.Kind() == reflect.Interface && !arg.IsNil() {
+						arg = arg.Elem()
+					}
+					args = append(args, reflect.ValueOf(arg))
+				}
+				break
+			}
 			// have to do the double reflect.ValueOf that runVMFunction expects
 			args = append(args, reflect.ValueOf(in[i]))
 		}
+		if !rv.Type().IsVariadic() && len(args) != rv.Type().NumIn() {
+			return returnError(fmt.Errorf("function wants %v arguments but received %v", rv.Type().NumIn()-1, len(args)-1))
+		}
 
 		// Call runVMFunction
 		rvs := rv.Call(args)
@@ -161,19 +236,25 @@ func convertVMFunctionToType(rv reflect.Value, rt reflect.Type) (reflect.Value,
 		// returns normal VM reflect.Value form
 		rv, err := processCallReturnValues(rvs, true, false)
 		if err != nil {
-			panic(err)
+			return returnError(err)
 		}
 
-		if rt.NumOut() < 1 {
+		if numOut < 1 {
 			// Go function does not want any return values, so give it none
+			if hasError {
+				return []reflect.Value{reflect.Zero(errorType)}
+			}
 			return []reflect.Value{}
 		}
-		if rt.NumOut() < 2 {
+		if numOut < 2 {
 			// Go function wants one return value
 			// will try to covert to reflect.Value correct type and return
-			rv, err = convertReflectValueToType(rv, rt.Out(0))
+			rv, err = convertReflectValueToType(ctx, rv, rt.Out(0))
 			if err != nil {
-				panic("function wants return type " + rt.Out(0).String() + " but received type " + rv.Type().String())
+				return returnError(errors.New("function wants return type " + rt.Out(0).String() + " but received type " + rv.Type().String()))
+			}
+			if hasError {
+				return []reflect.Value{rv, reflect.Zero(errorType)}
 			}
 			return []reflect.Value{rv}
 		}
@@ -181,21 +262,27 @@ func convertVMFunctionToType(rv reflect.Value, rt reflect.Type) (reflect.Value,
 		// Go function wants more than one return value
 		// make sure we have a slice/array with enought values
 
+		if rv.Kind() == reflect.Interface && !rv.IsNil() {
+			rv = rv.Elem()
+		}
 		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
-			panic(fmt.Sprintf("function wants %v return values but received %v", rt.NumOut(), rv.Kind().String()))
+			return returnError(fmt.Errorf("function wants %v return values but received %v", numOut, rv.Kind().String()))
 		}
-		if rv.Len() < rt.NumOut() {
-			panic(fmt.Sprintf("function wants %v return values but received %v values", rt.NumOut(), rv.Len()))
+		if rv.Len() < numOut {
+			return returnError(fmt.Errorf("function wants %v return values but received %v values", numOut, rv.Len()))
 		}
 
 		// try to covert each value in slice to wanted type and put into a reflect.Value slice
 		rvs = make([]reflect.Value, rt.NumOut())
-		for i := 0; i < rv.Len(); i++ {
-			rvs[i], err = convertReflectValueToType(rv.Index(i), rt.Out(i))
+		for i := 0; i < numOut; i++ {
+			rvs[i], err = convertReflectValueToType(ctx, rv.Index(i), rt.Out(i))
 			if err != nil {
-				panic("function wants return type " + rt.Out(i).String() + " but received type " + rvs[i].Type().String())
+				return returnError(errors.New("function wants return type " + rt.Out(i).String() + " but received type " + rvs[i].Type().String()))
 			}
 		}
+		if hasError {
+			rvs[numOut] = reflect.Zero(errorType)
+		}
 
 		// return created reflect.Value slice
 		return rvs
@@ -0,0 +1,237 @@
+package vm
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mattn/anko/ast"
+)
+
+// Coverage counts the runs of the statements and the outcomes of the branches of a script.
+// Set it in Options to count a run. The counts add up over runs, and a Coverage can be used by many runs at the same time.
+//
+// Statements and branches are known once they run. Add the nodes of the script with AddNode
+// so those that never run are reported with zero counts.
+type Coverage struct {
+	mutex      sync.Mutex
+	statements map[ast.Position]int64
+	branches   map[coverageBranchKey]*CoverageBranch
+}
+
+// CoverageStatement is the run count of a statement.
+type CoverageStatement struct {
+	Pos   ast.Position
+	Count int64
+}
+
+// CoverageBranch is the outcome counts of a branching statement or expression.
+type CoverageBranch struct {
+	// Pos is the position of the statement or expression.
+	Pos ast.Position
+	// Kind is if, switch, ternary or ??.
+	Kind string
+	// Outcomes are the names of the outcomes:
+	// then, else if 1, else if 2, ..., else for if, case 1, case 2, ..., default for switch,
+	// true, false for ternary and left, right for ??.
+	// The else and default outcomes count when no branch is taken, even if there is no else or default.
+	Outcomes []string
+	// Counts are the counts of the outcomes.
+	Counts []int64
+}
+
+// coverageBranchKey is the key of a branch, a position can have a statement and an expression of another kind
+type coverageBranchKey struct {
+	pos  ast.Position
+	kind string
+}
+
+// NewCoverage returns a new Coverage.
+func NewCoverage() *Coverage {
+	return &Coverage{
+		statements: make(map[ast.Position]int64),
+		branches:   make(map[coverageBranchKey]*CoverageBranch),
+	}
+}
+
+// AddNode adds a statement or a branching expression with zero counts if not already added.
+// StmtsStmt, SwitchCaseStmt and other expressions are ignored.
+// The else if statements of an IfStmt are part of its branch and must not be added.
+func (coverage *Coverage) AddNode(node interface{}) {
+	coverage.mutex.Lock()
+	defer coverage.mutex.Unlock()
+
+	branch := newCoverageBranch(node)
+	if branch != nil {
+		key := coverageBranchKey{pos: branch.Pos, kind: branch.Kind}
+		if _, ok := coverage.branches[key]; !ok {
+			coverage.branches[key] = branch
+		}
+	}
+
+	switch stmt := node.(type) {
+	case *ast.StmtsStmt, *ast.SwitchCaseStmt:
+	case ast.Stmt:
+		if !isStmt(stmt) {
+			return
+		}
+		if _, ok := coverage.statements[stmt.Position()]; !ok {
+			coverage.statements[stmt.Position()] = 0
+		}
+	}
+}
+
+// Statements returns the statement counts sorted by position.
+func (coverage *Coverage) Statements() []CoverageStatement {
+	coverage.mutex.Lock()
+	statements := make([]CoverageStatement, 0, len(coverage.statements))
+	for pos, count := range coverage.statements {
+		statements = append(statements, CoverageStatement{Pos: pos, Count: count})
+	}
+	coverage.mutex.Unlock()
+
+	sort.Slice(statements, func(i, j int) bool {
+		return positionLess(statements[i].Pos, statements[j].Pos)
+	})
+	return statements
+}
+
+// Branches returns the branch counts sorted by position.
+func (coverage *Coverage) Branches() []CoverageBranch {
+	coverage.mutex.Lock()
+	branches := make([]CoverageBranch, 0, len(coverage.branches))
+	for _, branch := range coverage.branches {
+		branches = append(branches, copyCoverageBranch(branch))
+	}
+	coverage.mutex.Unlock()
+
+	sort.Slice(branches, func(i, j int) bool {
+		if branches[i].Pos != branches[j].Pos {
+			return positionLess(branches[i].Pos, branches[j].Pos)
+		}
+		return branches[i].Kind < branches[j].Kind
+	})
+	return branches
+}
+
+// Merge adds the counts of other to coverage.
+func (coverage *Coverage) Merge(other *Coverage) {
+	statements := other.Statements()
+	branches := other.Branches()
+
+	coverage.mutex.Lock()
+	defer coverage.mutex.Unlock()
+
+	for _, statement := range statements {
+		coverage.statements[statement.Pos] += statement.Count
+	}
+	for _, otherBranch := range branches {
+		key := coverageBranchKey{pos: otherBranch.Pos, kind: otherBranch.Kind}
+		branch, ok := coverage.branches[key]
+		if !ok {
+			copied := copyCoverageBranch(&otherBranch)
+			coverage.branches[key] = &copied
+			continue
+		}
+		for i := 0; i < len(branch.Counts) && i < len(otherBranch.Counts); i++ {
+			branch.Counts[i] += otherBranch.Counts[i]
+		}
+	}
+}
+
+// countStatement counts a run of the statement
+func (coverage *Coverage) countStatement(stmt ast.Stmt) {
+	coverage.mutex.Lock()
+	coverage.statements[stmt.Position()]++
+	coverage.mutex.Unlock()
+}
+
+// countBranch counts the outcome of the branching statement or expression
+func (coverage *Coverage) countBranch(node ast.Pos, outcome int) {
+	coverage.mutex.Lock()
+	defer coverage.mutex.Unlock()
+
+	key := coverageBranchKey{pos: node.Position(), kind: coverageBranchKind(node)}
+	branch, ok := coverage.branches[key]
+	if !ok {
+		branch = newCoverageBranch(node)
+		coverage.branches[key] = branch
+	}
+	if outcome < len(branch.Counts) {
+		branch.Counts[outcome]++
+	}
+}
+
+// newCoverageBranch returns the branch with zero counts for the node, or nil if the node does not branch
+func newCoverageBranch(node interface{}) *CoverageBranch {
+	var outcomes []string
+	switch node := node.(type) {
+	case *ast.IfStmt:
+		outcomes = append(outcomes, "then")
+		for i := range node.ElseIf {
+			outcomes = append(outcomes, fmt.Sprintf("else if %v", i+1))
+		}
+		outcomes = append(outcomes, "else")
+	case *ast.SwitchStmt:
+		for i := range node.Cases {
+			outcomes = append(outcomes, fmt.Sprintf("case %v", i+1))
+		}
+		outcomes = append(outcomes, "default")
+	case *ast.TernaryOpExpr:
+		outcomes = []string{"true", "false"}
+	case *ast.NilCoalescingOpExpr:
+		outcomes = []string{"left", "right"}
+	default:
+		return nil
+	}
+
+	pos := node.(ast.Pos)
+	return &CoverageBranch{
+		Pos:      pos.Position(),
+		Kind:     coverageBranchKind(pos),
+		Outcomes: outcomes,
+		Counts:   make([]int64, len(outcomes)),
+	}
+}
+
+// coverageBranchKind returns the kind of the branching statement or expression
+func coverageBranchKind(node interface{}) string {
+	switch node.(type) {
+	case *ast.IfStmt:
+		return "if"
+	case *ast.SwitchStmt:
+		return "switch"
+	case *ast.TernaryOpExpr:
+		return "ternary"
+	case *ast.NilCoalescingOpExpr:
+		return "??"
+	}
+	return ""
+}
+
+// copyCoverageBranch returns a copy of the branch that does not share the counts
+func copyCoverageBranch(branch *CoverageBranch) CoverageBranch {
+	return CoverageBranch{
+		Pos:      branch.Pos,
+		Kind:     branch.Kind,
+		Outcomes: append([]string(nil), branch.Outcomes...),
+		Counts:   append([]int64(nil), branch.Counts...),
+	}
+}
+
+// isStmt returns true if the node is a statement.
+// ast.Stmt and ast.Expr are the same interface, the statement types are named with a Stmt suffix.
+func isStmt(node interface{}) bool {
+	nodeType := reflect.TypeOf(node)
+	return nodeType.Kind() == reflect.Ptr && strings.HasSuffix(nodeType.Elem().Name(), "Stmt")
+}
+
+// positionLess returns true if position a is before position b
+func positionLess(a ast.Position, b ast.Position) bool {
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+	return a.Column < b.Column
+}
@@ -0,0 +1,87 @@
+package vm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+)
+
+func TestCoverage(t *testing.T) {
+	t.Parallel()
+
+	script := `
+func f(n) {
+	if n == 1 {
+		return "one"
+	} else if n == 2 {
+		return "two"
+	}
+	switch n {
+	case 3:
+		return "three"
+	case 4, 5:
+		return "many"
+	}
+	return n > 9 ? "big" : nil ?? "small"
+}
+for i = 0; i < 4; i++ {
+	f(i)
+}
+`
+	stmt, err := parser.ParseSrc(script)
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	coverage := NewCoverage()
+	_, err = Run(env.NewEnv(), &Options{Coverage: coverage}, stmt)
+	if err != nil {
+		t.Fatal("Run error:", err)
+	}
+
+	counts := make(map[ast.Position]int64)
+	for _, statement := range coverage.Statements() {
+		counts[statement.Pos] = statement.Count
+	}
+	expectedCounts := map[ast.Position]int64{
+		{Line: 2, Column: 1}:  1,
+		{Line: 3, Column: 2}:  4,
+		{Line: 4, Column: 3}:  1,
+		{Line: 6, Column: 3}:  1,
+		{Line: 8, Column: 2}:  2,
+		{Line: 10, Column: 3}: 1,
+		{Line: 14, Column: 2}: 1,
+		{Line: 16, Column: 1}: 1,
+		{Line: 16, Column: 5}: 1,
+		{Line: 17, Column: 2}: 4,
+	}
+	if !reflect.DeepEqual(counts, expectedCounts) {
+		t.Errorf("statement counts - received: %v - expected: %v", counts, expectedCounts)
+	}
+
+	expectedBranches := []CoverageBranch{
+		{Pos: ast.Position{Line: 3, Column: 2}, Kind: "if", Outcomes: []string{"then", "else if 1", "else"}, Counts: []int64{1, 1, 2}},
+		{Pos: ast.Position{Line: 8, Column: 2}, Kind: "switch", Outcomes: []string{"case 1", "case 2", "default"}, Counts: []int64{1, 0, 1}},
+		{Pos: ast.Position{Line: 14, Column: 9}, Kind: "ternary", Outcomes: []string{"true", "false"}, Counts: []int64{0, 1}},
+		{Pos: ast.Position{Line: 14, Column: 25}, Kind: "??", Outcomes: []string{"left", "right"}, Counts: []int64{0, 1}},
+	}
+	branches := coverage.Branches()
+	if !reflect.DeepEqual(branches, expectedBranches) {
+		t.Errorf("branches - received: %+v - expected: %+v", branches, expectedBranches)
+	}
+
+	merged := NewCoverage()
+	merged.AddNode(&ast.ReturnStmt{})
+	merged.Merge(coverage)
+	merged.Merge(coverage)
+	statements := merged.Statements()
+	if len(statements) != len(expectedCounts)+1 || statements[0].Count != 0 || statements[1].Count != 2 {
+		t.Errorf("merged statements - received: %v", statements)
+	}
+	branches = merged.Branches()
+	if len(branches) != len(expectedBranches) || !reflect.DeepEqual(branches[0].Counts, []int64{2, 2, 4}) {
+		t.Errorf("merged branches - received: %+v", branches)
+	}
+}
@@ -0,0 +1,674 @@
+package vm
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DecodeOptions provides options to decode with
+type DecodeOptions struct {
+	// Strict returns an error for map keys that do not match a struct field
+	// and for values that can only be converted by parsing or truncating, like string to int or float to int.
+	Strict bool
+}
+
+// structTag is the parsed anko struct tag of a struct field
+type structTag struct {
+	name      string
+	named     bool
+	skip      bool
+	omitEmpty bool
+	readOnly  bool
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Decode decodes value, normally a script map or slice, into the Go value that out points to.
+// Struct fields are matched to map keys with the anko struct tag, `anko:"name,omitempty"`,
+// or by the field name, first exactly and then case-insensitive. A tag name of "-" skips the field.
+// Decode is lenient, see DecodeWithOptions for strict decoding.
+func Decode(value interface{}, out interface{}) error {
+	return DecodeWithOptions(value, out, nil)
+}
+
+// DecodeWithOptions decodes value into the Go value that out points to with options.
+func DecodeWithOptions(value interface{}, out interface{}, options *DecodeOptions) error {
+	outV := reflect.ValueOf(out)
+	if outV.Kind() != reflect.Ptr || outV.IsNil() {
+		return fmt.Errorf("decode out must be a non-nil pointer but received type %T", out)
+	}
+	if options == nil {
+		options = &DecodeOptions{}
+	}
+
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() {
+		rv = nilValue
+	}
+	return decodeValue("value", rv, outV.Elem(), options)
+}
+
+// decodeValue decodes rv into out, out must be settable
+func decodeValue(path string, rv reflect.Value, out reflect.Value, options *DecodeOptions) error {
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || isNil(rv) {
+		out.Set(reflect.Zero(out.Type()))
+		return nil
+	}
+
+	if rv.Type() == out.Type() && out.Kind() != reflect.Struct && out.Kind() != reflect.Map &&
+		out.Kind() != reflect.Slice && out.Kind() != reflect.Array && out.Kind() != reflect.Ptr {
+		out.Set(rv)
+		return nil
+	}
+
+	if out.Type() == durationType {
+		return decodeDuration(path, rv, out, options)
+	}
+
+	switch out.Kind() {
+	case reflect.Interface:
+		if !rv.Type().AssignableTo(out.Type()) {
+			return newDecodeError(path, rv, out)
+		}
+		out.Set(rv)
+		return nil
+
+	case reflect.Ptr:
+		if rv.Kind() == reflect.Ptr {
+			rv = rv.Elem()
+		}
+		ptrV := reflect.New(out.Type().Elem())
+		err := decodeValue(path, rv, ptrV.Elem(), options)
+		if err != nil {
+			return err
+		}
+		out.Set(ptrV)
+		return nil
+
+	case reflect.Struct:
+		if rv.Kind() == reflect.Ptr {
+			rv = rv.Elem()
+		}
+		if rv.Type() == out.Type() {
+			out.Set(rv)
+			return nil
+		}
+		if rv.Kind() != reflect.Map {
+			return newDecodeError(path, rv, out)
+		}
+		return decodeStruct(path, rv, out, options)
+
+	case reflect.Map:
+		if rv.Kind() != reflect.Map {
+			return newDecodeError(path, rv, out)
+		}
+		newMap, err := makeValue(out.Type())
+		if err != nil {
+			return err
+		}
+		keyType := out.Type().Key()
+		elemType := out.Type().Elem()
+		for _, key := range rv.MapKeys() {
+			newKey := reflect.New(keyType).Elem()
+			err = decodeValue(path, key, newKey, options)
+			if err != nil {
+				return err
+			}
+			newElem := reflect.New(elemType).Elem()
+			err = decodeValue(path+"["+fmt.Sprint(key.Interface())+"]", rv.MapIndex(key), newElem, options)
+			if err != nil {
+				return err
+			}
+			newMap.SetMapIndex(newKey, newElem)
+		}
+		out.Set(newMap)
+		return nil
+
+	case reflect.Slice:
+		if rv.Kind() == reflect.String && out.Type().Elem().Kind() == reflect.Uint8 {
+			out.Set(reflect.ValueOf([]byte(rv.String())).Convert(out.Type()))
+			return nil
+		}
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return newDecodeError(path, rv, out)
+		}
+		newSlice := reflect.MakeSlice(out.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			err := decodeValue(path+"["+strconv.Itoa(i)+"]", rv.Index(i), newSlice.Index(i), options)
+			if err != nil {
+				return err
+			}
+		}
+		out.Set(newSlice)
+		return nil
+
+	case reflect.Array:
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return newDecodeError(path, rv, out)
+		}
+		if rv.Len() > out.Len() || (options.Strict && rv.Len() != out.Len()) {
+			return fmt.Errorf("%v: cannot decode %v values into type %v", path, rv.Len(), out.Type())
+		}
+		newArray := reflect.New(out.Type()).Elem()
+		for i := 0; i < rv.Len(); i++ {
+			err := decodeValue(path+"["+strconv.Itoa(i)+"]", rv.Index(i), newArray.Index(i), options)
+			if err != nil {
+				return err
+			}
+		}
+		out.Set(newArray)
+		return nil
+
+	case reflect.Bool:
+		if rv.Kind() == reflect.Bool {
+			out.SetBool(rv.Bool())
+			return nil
+		}
+		if options.Strict {
+			return newDecodeError(path, rv, out)
+		}
+		b, err := tryToBool(rv)
+		if err != nil {
+			return newDecodeError(path, rv, out)
+		}
+		out.SetBool(b)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		switch {
+		case isInt(rv):
+			i = rv.Int()
+		case isUint(rv):
+			if rv.Uint() > math.MaxInt64 {
+				return newDecodeOverflowError(path, rv, out)
+			}
+			i = int64(rv.Uint())
+		case isFloat(rv) && (!options.Strict || rv.Float() == math.Trunc(rv.Float())):
+			i = int64(rv.Float())
+		case !options.Strict:
+			var err error
+			i, err = tryToInt64(rv)
+			if err != nil {
+				return newDecodeError(path, rv, out)
+			}
+		default:
+			return newDecodeError(path, rv, out)
+		}
+		if out.OverflowInt(i) {
+			return newDecodeOverflowError(path, rv, out)
+		}
+		out.SetInt(i)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var u uint64
+		switch {
+		case isUint(rv):
+			u = rv.Uint()
+		case isInt(rv):
+			if rv.Int() < 0 {
+				return newDecodeOverflowError(path, rv, out)
+			}
+			u = uint64(rv.Int())
+		case isFloat(rv) && (!options.Strict || rv.Float() == math.Trunc(rv.Float())):
+			if rv.Float() < 0 {
+				return newDecodeOverflowError(path, rv, out)
+			}
+			u = uint64(rv.Float())
+		case !options.Strict && rv.Kind() == reflect.String:
+			var err error
+			u, err = strconv.ParseUint(rv.String(), 10, 64)
+			if err != nil {
+				return newDecodeError(path, rv, out)
+			}
+		default:
+			return newDecodeError(path, rv, out)
+		}
+		if out.OverflowUint(u) {
+			return newDecodeOverflowError(path, rv, out)
+		}
+		out.SetUint(u)
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		switch {
+		case isFloat(rv):
+			f = rv.Float()
+		case isInt(rv):
+			f = float64(rv.Int())
+		case isUint(rv):
+			f = float64(rv.Uint())
+		case !options.Strict:
+			var err error
+			f, err = tryToFloat64(rv)
+			if err != nil {
+				return newDecodeError(path, rv, out)
+			}
+		default:
+			return newDecodeError(path, rv, out)
+		}
+		if out.OverflowFloat(f) {
+			return newDecodeOverflowError(path, rv, out)
+		}
+		out.SetFloat(f)
+		return nil
+
+	case reflect.String:
+		switch {
+		case rv.Kind() == reflect.String:
+			out.SetString(rv.String())
+		case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+			out.SetString(string(rv.Bytes()))
+		case !options.Strict && (isNum(rv) || rv.Kind() == reflect.Bool):
+			out.SetString(fmt.Sprint(rv.Interface()))
+		default:
+			return newDecodeError(path, rv, out)
+		}
+		return nil
+	}
+
+	if rv.Type().ConvertibleTo(out.Type()) {
+		out.Set(rv.Convert(out.Type()))
+		return nil
+	}
+	return newDecodeError(path, rv, out)
+}
+
+// decodeStruct decodes map rv into struct out
+func decodeStruct(path string, rv reflect.Value, out reflect.Value, options *DecodeOptions) error {
+	fields := structFields(out.Type())
+	newStruct := reflect.New(out.Type()).Elem()
+	newStruct.Set(out)
+
+	for _, key := range rv.MapKeys() {
+		keyV := key
+		if keyV.Kind() == reflect.Interface && !keyV.IsNil() {
+			keyV = keyV.Elem()
+		}
+		if keyV.Kind() != reflect.String {
+			if options.Strict {
+				return fmt.Errorf("%v: cannot decode map key type %v into struct field name", path, keyV.Type())
+			}
+			continue
+		}
+		name := keyV.String()
+
+		field, found := findStructField(fields, name)
+		if !found {
+			if options.Strict {
+				return fmt.Errorf("%v: no field for key '%v' in type %v", path, name, out.Type())
+			}
+			continue
+		}
+
+		fieldV, err := fieldByIndexAlloc(newStruct, field.Index)
+		if err != nil {
+			return fmt.Errorf("%v.%v: %v", path, name, err)
+		}
+		err = decodeValue(path+"."+name, rv.MapIndex(key), fieldV, options)
+		if err != nil {
+			return err
+		}
+	}
+
+	out.Set(newStruct)
+	return nil
+}
+
+// decodeDuration decodes rv into time.Duration out.
+// Strings are parsed with time.ParseDuration and numbers are nanoseconds.
+func decodeDuration(path string, rv reflect.Value, out reflect.Value, options *DecodeOptions) error {
+	switch {
+	case rv.Kind() == reflect.String:
+		duration, err := time.ParseDuration(rv.String())
+		if err != nil {
+			return fmt.Errorf("%v: %v", path, err)
+		}
+		out.SetInt(int64(duration))
+	case isInt(rv):
+		out.SetInt(rv.Int())
+	case isFloat(rv) && !options.Strict:
+		out.SetInt(int64(rv.Float()))
+	default:
+		return newDecodeError(path, rv, out)
+	}
+	return nil
+}
+
+// fieldByIndexAlloc is FieldByIndex but allocates nil embedded struct pointers
+func fieldByIndexAlloc(v reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return v, fmt.Errorf("cannot set embedded pointer to unexported struct %v", v.Type().Elem())
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, nil
+}
+
+func newDecodeError(path string, rv reflect.Value, out reflect.Value) error {
+	return fmt.Errorf("%v: cannot decode type %v into type %v", path, rv.Type(), out.Type())
+}
+
+func newDecodeOverflowError(path string, rv reflect.Value, out reflect.Value) error {
+	return fmt.Errorf("%v: value %v overflows type %v", path, rv.Interface(), out.Type())
+}
+
+// Encode encodes a Go value into script values.
+// Structs become map[interface{}]interface{} using the same field names as Decode, except structs without exported fields
+// like time.Time, which are kept as they are. Slices and arrays become []interface{},
+// maps become map[interface{}]interface{}, pointers are followed, and numbers become int64 or float64,
+// except unsigned integers above math.MaxInt64, which become uint64 so they do not overflow.
+func Encode(value interface{}) (interface{}, error) {
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() {
+		return nil, nil
+	}
+	rv, err := encodeValue(rv)
+	if err != nil {
+		return nil, err
+	}
+	return rv.Interface(), nil
+}
+
+// encodeValue encodes rv into script value
+func encodeValue(rv reflect.Value) (reflect.Value, error) {
+	if rv.Kind() == reflect.Interface || rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nilValue, nil
+		}
+		return encodeValue(rv.Elem())
+	}
+
+	if rv.Type() == durationType {
+		return reflect.ValueOf(time.Duration(rv.Int())), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return reflect.ValueOf(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if rv.Uint() > math.MaxInt64 {
+			return reflect.ValueOf(rv.Uint()), nil
+		}
+		return reflect.ValueOf(int64(rv.Uint())), nil
+	case reflect.Float32, reflect.Float64:
+		return reflect.ValueOf(rv.Float()), nil
+	case reflect.String:
+		return reflect.ValueOf(rv.String()), nil
+
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice {
+			if rv.IsNil() {
+				return nilValue, nil
+			}
+			if rv.Type().Elem().Kind() == reflect.Uint8 {
+				return reflect.ValueOf(append([]byte(nil), rv.Bytes()...)), nil
+			}
+		}
+		slice := make([]interface{}, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			value, err := encodeValue(rv.Index(i))
+			if err != nil {
+				return nilValue, err
+			}
+			slice[i] = value.Interface()
+		}
+		return reflect.ValueOf(slice), nil
+
+	case reflect.Map:
+		if rv.IsNil() {
+			return nilValue, nil
+		}
+		aMap := make(map[interface{}]interface{}, rv.Len())
+		for _, key := range rv.MapKeys() {
+			newKey, err := encodeValue(key)
+			if err != nil {
+				return nilValue, err
+			}
+			value, err := encodeValue(rv.MapIndex(key))
+			if err != nil {
+				return nilValue, err
+			}
+			aMap[newKey.Interface()] = value.Interface()
+		}
+		return reflect.ValueOf(aMap), nil
+
+	case reflect.Struct:
+		fields := structFields(rv.Type())
+		if len(fields) == 0 {
+			// opaque struct like time.Time
+			return rv, nil
+		}
+		aMap := make(map[interface{}]interface{}, len(fields))
+		for _, field := range fields {
+			fieldV, ok := fieldByIndexNoAlloc(rv, field.Index)
+			if !ok {
+				continue
+			}
+			if field.tag.omitEmpty && isEmptyValue(fieldV) {
+				continue
+			}
+			value, err := encodeValue(fieldV)
+			if err != nil {
+				return nilValue, fmt.Errorf("%v: %v", field.tag.name, err)
+			}
+			aMap[field.tag.name] = value.Interface()
+		}
+		return reflect.ValueOf(aMap), nil
+	}
+
+	return rv, nil
+}
+
+// fieldByIndexNoAlloc is FieldByIndex but returns false for nil embedded struct pointers
+func fieldByIndexNoAlloc(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return v, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr, reflect.Chan, reflect.Func:
+		return v.IsNil()
+	}
+	return false
+}
+
+func isInt(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isUint(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func isFloat(v reflect.Value) bool {
+	return v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64
+}
+
+// structField is an exported struct field with its anko struct tag
+type structField struct {
+	reflect.StructField
+	tag structTag
+}
+
+// parseStructTag parses the anko struct tag of field.
+// If there is no tag name, the field name is used.
+func parseStructTag(field reflect.StructField) structTag {
+	tag := structTag{name: field.Name}
+	value, ok := field.Tag.Lookup("anko")
+	if !ok {
+		return tag
+	}
+	if value == "-" {
+		tag.skip = true
+		return tag
+	}
+	options := strings.Split(value, ",")
+	if options[0] != "" {
+		tag.name = options[0]
+		tag.named = true
+	}
+	for _, option := range options[1:] {
+		switch option {
+		case "omitempty":
+			tag.omitEmpty = true
+		case "readonly":
+			tag.readOnly = true
+		}
+	}
+	return tag
+}
+
+// structFields returns the exported fields of struct type t, including fields of embedded structs without a tag name.
+// Like Go and encoding/json, of the fields with the same name the shallowest one is used,
+// preferring a field with a tag name, and names that are still ambiguous at that depth are dropped.
+func structFields(t reflect.Type) []structField {
+	fields := embeddedStructFields(t, nil, make(map[reflect.Type]struct{}))
+	sort.SliceStable(fields, func(i, j int) bool {
+		return len(fields[i].Index) < len(fields[j].Index)
+	})
+
+	byName := make(map[string][]structField, len(fields))
+	for _, field := range fields {
+		byName[field.tag.name] = append(byName[field.tag.name], field)
+	}
+
+	selected := make([]structField, 0, len(fields))
+	for _, field := range fields {
+		sameName, ok := byName[field.tag.name]
+		if !ok {
+			continue
+		}
+		delete(byName, field.tag.name)
+		field, ok = dominantStructField(sameName)
+		if ok {
+			selected = append(selected, field)
+		}
+	}
+	return selected
+}
+
+// embeddedStructFields returns all the exported fields of struct type t with index as the index prefix.
+// The struct types of path are the ones being embedded, so a struct that embeds a pointer to itself does not recurse.
+func embeddedStructFields(t reflect.Type, index []int, path map[reflect.Type]struct{}) []structField {
+	path[t] = struct{}{}
+	defer delete(path, t)
+
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := parseStructTag(field)
+		if tag.skip {
+			continue
+		}
+		fieldIndex := make([]int, len(index)+1)
+		copy(fieldIndex, index)
+		fieldIndex[len(index)] = i
+		if field.Anonymous {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if _, hasTag := field.Tag.Lookup("anko"); !hasTag && fieldType.Kind() == reflect.Struct {
+				if _, ok := path[fieldType]; !ok {
+					fields = append(fields, embeddedStructFields(fieldType, fieldIndex, path)...)
+				}
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+		field.Index = fieldIndex
+		fields = append(fields, structField{StructField: field, tag: tag})
+	}
+	return fields
+}
+
+// dominantStructField returns the field that is used of fields with the same name, sorted by depth.
+// It returns false if there is more than one shallowest field and not exactly one of them has a tag name.
+func dominantStructField(fields []structField) (structField, bool) {
+	depth := len(fields[0].Index)
+	var dominant structField
+	count := 0
+	named := 0
+	for _, field := range fields {
+		if len(field.Index) > depth {
+			break
+		}
+		count++
+		if field.tag.named {
+			named++
+			dominant = field
+		}
+	}
+	if count == 1 {
+		return fields[0], true
+	}
+	if named == 1 {
+		return dominant, true
+	}
+	return structField{}, false
+}
+
+// findStructField finds the field with name, first exactly and then case-insensitive
+func findStructField(fields []structField, name string) (structField, bool) {
+	for _, field := range fields {
+		if field.tag.name == name {
+			return field, true
+		}
+	}
+	for _, field := range fields {
+		if strings.EqualFold(field.tag.name, name) {
+			return field, true
+		}
+	}
+	return structField{}, false
+}
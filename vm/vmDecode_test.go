@@ -0,0 +1,290 @@
+package vm
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mattn/anko/env"
+)
+
+type (
+	testDecodeServer struct {
+		Host    string
+		Port    uint16
+		Timeout time.Duration
+	}
+	testDecodeEmbedded struct {
+		Name string `anko:"name"`
+	}
+	testDecodeConfig struct {
+		testDecodeEmbedded
+		UserID   int64              `anko:"user_id"`
+		Enabled  bool               `anko:"enabled,omitempty"`
+		Ratio    float32            `anko:"ratio,omitempty"`
+		Tags     []string           `anko:"tags,omitempty"`
+		Limits   map[string]int     `anko:"limits,omitempty"`
+		Server   testDecodeServer   `anko:"server"`
+		Backup   *testDecodeServer  `anko:"backup,omitempty"`
+		Replicas []testDecodeServer `anko:"replicas,omitempty"`
+		Secret   string             `anko:"-"`
+		Plain    string
+		private  string
+	}
+	testDecodeRecursive struct {
+		*testDecodeRecursive
+		A int64
+	}
+	testDecodeBase struct {
+		Host string
+	}
+	testDecodeLeft struct {
+		testDecodeBase
+		Left int64
+	}
+	testDecodeRight struct {
+		*testDecodeBase
+		Right int64
+	}
+	testDecodeDiamond struct {
+		testDecodeLeft
+		testDecodeRight
+	}
+	testDecodeDiamondHost struct {
+		testDecodeLeft
+		testDecodeRight
+		Host string
+	}
+	testDecodeTagged struct {
+		Value int64 `anko:"Port"`
+	}
+	testDecodeConflict struct {
+		testDecodeEmbedded
+		testDecodeServer
+		testDecodeTagged
+		testDecodeRight
+	}
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	value, err := Execute(e, nil, `
+{
+	"name": "test",
+	"user_id": 12,
+	"enabled": true,
+	"ratio": 0.5,
+	"tags": ["a", "b"],
+	"limits": {"a": 1, "b": 2},
+	"server": {"Host": "localhost", "port": 8080, "timeout": "1.5s"},
+	"backup": {"host": "backup", "Timeout": 1000},
+	"replicas": [{"host": "r1"}, {"host": "r2"}],
+	"Secret": "secret",
+	"plain": "plain",
+	"private": "private",
+	"unknown": "unknown",
+}
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	var config testDecodeConfig
+	err = Decode(value, &config)
+	if err != nil {
+		t.Fatal("Decode error:", err)
+	}
+	expected := testDecodeConfig{
+		testDecodeEmbedded: testDecodeEmbedded{Name: "test"},
+		UserID:             12,
+		Enabled:            true,
+		Ratio:              0.5,
+		Tags:               []string{"a", "b"},
+		Limits:             map[string]int{"a": 1, "b": 2},
+		Server:             testDecodeServer{Host: "localhost", Port: 8080, Timeout: 1500 * time.Millisecond},
+		Backup:             &testDecodeServer{Host: "backup", Timeout: 1000},
+		Replicas:           []testDecodeServer{{Host: "r1"}, {Host: "r2"}},
+		Plain:              "plain",
+	}
+	if !reflect.DeepEqual(config, expected) {
+		t.Errorf("Decode - received: %#v - expected: %#v", config, expected)
+	}
+
+	value = map[interface{}]interface{}{"name": "test", "Secret": "secret"}
+	err = DecodeWithOptions(value, &config, &DecodeOptions{Strict: true})
+	expectedError := "value: no field for key 'Secret' in type vm.testDecodeConfig"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Decode error - received: %v - expected: %v", err, expectedError)
+	}
+
+	value = map[interface{}]interface{}{"user_id": "12", "enabled": "yes", "ratio": "0.25", "tags": []interface{}{1, true}}
+	config = testDecodeConfig{}
+	err = Decode(value, &config)
+	if err != nil {
+		t.Fatal("Decode error:", err)
+	}
+	expected = testDecodeConfig{UserID: 12, Enabled: true, Ratio: 0.25, Tags: []string{"1", "true"}}
+	if !reflect.DeepEqual(config, expected) {
+		t.Errorf("Decode - received: %#v - expected: %#v", config, expected)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value         interface{}
+		out           interface{}
+		strict        bool
+		expectedError string
+	}{
+		{value: int64(1), out: 1, expectedError: "decode out must be a non-nil pointer but received type int"},
+		{value: int64(1), out: (*int)(nil), expectedError: "decode out must be a non-nil pointer but received type *int"},
+		{value: "a", out: new(int), expectedError: "value: cannot decode type string into type int"},
+		{value: "1", out: new(int), strict: true, expectedError: "value: cannot decode type string into type int"},
+		{value: float64(1.5), out: new(int), strict: true, expectedError: "value: cannot decode type float64 into type int"},
+		{value: int64(256), out: new(uint8), expectedError: "value: value 256 overflows type uint8"},
+		{value: int64(-1), out: new(uint), expectedError: "value: value -1 overflows type uint"},
+		{value: int64(1), out: new(string), strict: true, expectedError: "value: cannot decode type int64 into type string"},
+		{value: "a", out: new(bool), strict: true, expectedError: "value: cannot decode type string into type bool"},
+		{value: "1x", out: new(time.Duration), expectedError: "value: time: unknown unit \"x\" in duration \"1x\""},
+		{value: []interface{}{int64(1), "a"}, out: new([]int), expectedError: "value[1]: cannot decode type string into type int"},
+		{value: []interface{}{int64(1), int64(2), int64(3)}, out: new([2]int), expectedError: "value: cannot decode 3 values into type [2]int"},
+		{value: []interface{}{int64(1)}, out: new([2]int), strict: true, expectedError: "value: cannot decode 1 values into type [2]int"},
+		{value: "a", out: new(testDecodeServer), expectedError: "value: cannot decode type string into type vm.testDecodeServer"},
+		{value: map[interface{}]interface{}{"server": map[interface{}]interface{}{"Port": "a"}}, out: new(testDecodeConfig), expectedError: "value.server.Port: cannot decode type string into type uint16"},
+		{value: map[interface{}]interface{}{int64(1): "a"}, out: new(testDecodeServer), strict: true, expectedError: "value: cannot decode map key type int64 into struct field name"},
+		{value: map[interface{}]interface{}{"a": "a"}, out: new(map[string]int), expectedError: "value[a]: cannot decode type string into type int"},
+	}
+
+	for _, test := range tests {
+		err := DecodeWithOptions(test.value, test.out, &DecodeOptions{Strict: test.strict})
+		if err == nil || err.Error() != test.expectedError {
+			t.Errorf("Decode error - received: %v - expected: %v - value: %#v", err, test.expectedError, test.value)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	config := testDecodeConfig{
+		testDecodeEmbedded: testDecodeEmbedded{Name: "test"},
+		UserID:             12,
+		Limits:             map[string]int{"a": 1},
+		Server:             testDecodeServer{Host: "localhost", Port: 8080, Timeout: time.Second},
+		Secret:             "secret",
+	}
+	value, err := Encode(&config)
+	if err != nil {
+		t.Fatal("Encode error:", err)
+	}
+	expected := map[interface{}]interface{}{
+		"name":    "test",
+		"user_id": int64(12),
+		"limits":  map[interface{}]interface{}{"a": int64(1)},
+		"server":  map[interface{}]interface{}{"Host": "localhost", "Port": int64(8080), "Timeout": time.Second},
+		"Plain":   "",
+	}
+	if !reflect.DeepEqual(value, expected) {
+		t.Errorf("Encode - received: %#v - expected: %#v", value, expected)
+	}
+
+	e := env.NewEnv()
+	err = e.Define("config", value)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	value, err = Execute(e, nil, `config.server.Port + config.limits.a`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if value != int64(8081) {
+		t.Errorf("Execute - received: %#v - expected: %#v", value, int64(8081))
+	}
+
+	var decoded testDecodeConfig
+	err = Decode(&config, &decoded)
+	if err != nil || !reflect.DeepEqual(decoded, config) {
+		t.Errorf("Decode - received: %#v, %v - expected: %#v", decoded, err, config)
+	}
+
+	value, err = Encode([]interface{}{int32(1), []byte("a"), nil, [2]uint{1, 2}, (*int)(nil)})
+	if err != nil {
+		t.Fatal("Encode error:", err)
+	}
+	expectedSlice := []interface{}{int64(1), []byte("a"), nil, []interface{}{int64(1), int64(2)}, nil}
+	if !reflect.DeepEqual(value, expectedSlice) {
+		t.Errorf("Encode - received: %#v - expected: %#v", value, expectedSlice)
+	}
+
+	value, err = Encode(nil)
+	if err != nil || value != nil {
+		t.Errorf("Encode - received: %#v, %v - expected: %#v", value, err, nil)
+	}
+
+	// a struct without exported fields is not a map
+	now := time.Now()
+	value, err = Encode(map[string]interface{}{"a": now})
+	expectedMap := map[interface{}]interface{}{"a": now}
+	if err != nil || !reflect.DeepEqual(value, expectedMap) {
+		t.Errorf("Encode - received: %#v, %v - expected: %#v", value, err, expectedMap)
+	}
+
+	// a struct that embeds a pointer to itself
+	value, err = Encode(testDecodeRecursive{testDecodeRecursive: &testDecodeRecursive{A: 2}, A: 1})
+	expectedMap = map[interface{}]interface{}{"A": int64(1)}
+	if err != nil || !reflect.DeepEqual(value, expectedMap) {
+		t.Errorf("Encode - received: %#v, %v - expected: %#v", value, err, expectedMap)
+	}
+	var decoded2 testDecodeRecursive
+	err = Decode(map[string]interface{}{"A": 3}, &decoded2)
+	if err != nil || decoded2.A != 3 {
+		t.Errorf("Decode - received: %#v, %v - expected: %#v", decoded2, err, 3)
+	}
+
+	// the same struct embedded twice at the same depth, its fields are ambiguous
+	value, err = Encode(testDecodeDiamond{testDecodeLeft{testDecodeBase{"a"}, 1}, testDecodeRight{&testDecodeBase{"b"}, 2}})
+	expectedMap = map[interface{}]interface{}{"Left": int64(1), "Right": int64(2)}
+	if err != nil || !reflect.DeepEqual(value, expectedMap) {
+		t.Errorf("Encode - received: %#v, %v - expected: %#v", value, err, expectedMap)
+	}
+	value, err = Encode(testDecodeDiamondHost{testDecodeLeft{testDecodeBase{"a"}, 1}, testDecodeRight{&testDecodeBase{"b"}, 2}, "c"})
+	expectedMap = map[interface{}]interface{}{"Left": int64(1), "Right": int64(2), "Host": "c"}
+	if err != nil || !reflect.DeepEqual(value, expectedMap) {
+		t.Errorf("Encode - received: %#v, %v - expected: %#v", value, err, expectedMap)
+	}
+	var decoded3 testDecodeDiamond
+	err = DecodeWithOptions(map[string]interface{}{"Host": "a"}, &decoded3, &DecodeOptions{Strict: true})
+	expectedError := "value: no field for key 'Host' in type vm.testDecodeDiamond"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Decode - received: %v - expected: %v", err, expectedError)
+	}
+
+	// fields with the same name at the same depth are dropped, unless exactly one has a tag name,
+	// and the shallower Host hides the Host of testDecodeRight
+	value, err = Encode(testDecodeConflict{
+		testDecodeEmbedded: testDecodeEmbedded{Name: "a"},
+		testDecodeServer:   testDecodeServer{Host: "b", Port: 1, Timeout: time.Second},
+		testDecodeTagged:   testDecodeTagged{Value: 2},
+		testDecodeRight:    testDecodeRight{&testDecodeBase{"c"}, 3},
+	})
+	expectedMap = map[interface{}]interface{}{"name": "a", "Host": "b", "Port": int64(2), "Timeout": time.Second, "Right": int64(3)}
+	if err != nil || !reflect.DeepEqual(value, expectedMap) {
+		t.Errorf("Encode - received: %#v, %v - expected: %#v", value, err, expectedMap)
+	}
+	var decoded4 testDecodeConflict
+	err = Decode(map[string]interface{}{"Host": "a", "Port": 1}, &decoded4)
+	if err != nil || decoded4.testDecodeServer.Host != "a" || decoded4.testDecodeServer.Port != 0 || decoded4.Value != 1 {
+		t.Errorf("Decode - received: %#v, %v", decoded4, err)
+	}
+
+	// unsigned integers above math.MaxInt64 stay uint64
+	value, err = Encode(uint64(math.MaxUint64))
+	if err != nil || value != uint64(math.MaxUint64) {
+		t.Errorf("Encode - received: %#v, %v - expected: %#v", value, err, uint64(math.MaxUint64))
+	}
+}
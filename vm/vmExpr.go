@@ -63,7 +63,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 				return
 			}
 
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, valueType)
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, valueType)
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "cannot use type "+runInfo.rv.Type().String()+" as type "+valueType.String()+" as slice value")
 				runInfo.rv = nilValue
@@ -126,7 +126,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			if runInfo.err != nil {
 				return
 			}
-			key, runInfo.err = convertReflectValueToType(runInfo.rv, keyType)
+			key, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, keyType)
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "cannot use type "+key.Type().String()+" as type "+keyType.String()+" as map key")
 				runInfo.rv = nilValue
@@ -138,7 +138,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			if runInfo.err != nil {
 				return
 			}
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, valueType)
+			runInfo.rv, runInfo.err = convertMapValueToType(runInfo.ctx, runInfo.rv, t)
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "cannot use type "+runInfo.rv.Type().String()+" as type "+valueType.String()+" as map value")
 				runInfo.rv = nilValue
@@ -241,6 +241,15 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			return
 		}
 
+		if members := getTypeMembers(runInfo.rv.Type()); members != nil {
+			runInfo.rv, runInfo.err = members.value(runInfo.ctx, runInfo.rv, expr.Name)
+			if runInfo.err != nil {
+				runInfo.err = newError(expr, runInfo.err)
+				runInfo.rv = nilValue
+			}
+			return
+		}
+
 		value := runInfo.rv.MethodByName(expr.Name)
 		if value.IsValid() {
 			runInfo.rv = value
@@ -269,7 +278,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			runInfo.err = newStringError(expr, "no member named '"+expr.Name+"' for struct")
 			runInfo.rv = nilValue
 		case reflect.Map:
-			runInfo.rv = getMapIndex(reflect.ValueOf(expr.Name), runInfo.rv)
+			runInfo.rv = getMapIndex(runInfo.ctx, reflect.ValueOf(expr.Name), runInfo.rv)
 		default:
 			runInfo.err = newStringError(expr, "type "+runInfo.rv.Kind().String()+" does not support member operation")
 			runInfo.rv = nilValue
@@ -315,7 +324,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 				runInfo.rv = item.Index(index).Convert(stringType)
 			}
 		case reflect.Map:
-			runInfo.rv = getMapIndex(runInfo.rv, item)
+			runInfo.rv = getMapIndex(runInfo.ctx, runInfo.rv, item)
 		default:
 			runInfo.err = newStringError(expr, "type "+item.Kind().String()+" does not support index operation")
 			runInfo.rv = nilValue
@@ -453,10 +462,15 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			return
 		}
 
+		outcome := 0
 		if toBool(runInfo.rv) {
 			runInfo.expr = expr.LHS
 		} else {
 			runInfo.expr = expr.RHS
+			outcome = 1
+		}
+		if runInfo.options.Coverage != nil {
+			runInfo.options.Coverage.countBranch(expr, outcome)
 		}
 		runInfo.invokeExpr()
 
@@ -468,11 +482,17 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		runInfo.invokeExpr()
 		if runInfo.err == nil {
 			if !isNil(runInfo.rv) {
+				if runInfo.options.Coverage != nil {
+					runInfo.options.Coverage.countBranch(expr, 0)
+				}
 				return
 			}
 		} else {
 			runInfo.err = nil
 		}
+		if runInfo.options.Coverage != nil {
+			runInfo.options.Coverage.countBranch(expr, 1)
+		}
 		runInfo.expr = expr.RHS
 		runInfo.invokeExpr()
 
@@ -503,7 +523,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		if runInfo.err != nil {
 			return
 		}
-		runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, stringType)
+		runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, stringType)
 		if runInfo.err != nil {
 			runInfo.rv = nilValue
 			return
@@ -603,8 +623,13 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			return
 		}
 
-		// if expr.Name has a dot in it, it should give a syntax error, so no needs to check err
-		runInfo.env.DefineReflectType(expr.Name, runInfo.rv.Type())
+		// if expr.Name has a dot in it, it should give a syntax error, so only frozen scope needs to be checked
+		runInfo.err = runInfo.env.DefineReflectType(expr.Name, runInfo.rv.Type())
+		if runInfo.err != nil {
+			runInfo.err = newError(expr, runInfo.err)
+			runInfo.rv = nilValue
+			return
+		}
 
 		runInfo.rv = reflect.ValueOf(runInfo.rv.Type())
 
@@ -686,7 +711,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		// chan lhs <- rhs is send
 
 		runInfo.rv = nilValue
-		rhs, runInfo.err = convertReflectValueToType(rhs, lhs.Type().Elem())
+		rhs, runInfo.err = convertReflectValueToType(runInfo.ctx, rhs, lhs.Type().Elem())
 		if runInfo.err != nil {
 			runInfo.err = newStringError(expr, "cannot use type "+rhs.Type().String()+" as type "+lhs.Type().Elem().String()+" to send to chan")
 			return
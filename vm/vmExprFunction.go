@@ -35,6 +35,21 @@ func (runInfo *runInfoStruct) funcExpr() {
 	// return value of the function and error value of the run
 	runVMFunction := func(in []reflect.Value) []reflect.Value {
 		runInfo := runInfoStruct{ctx: in[0].Interface().(context.Context), options: runInfo.options, env: envFunc.NewEnv(), stmt: funcExpr.Stmt, rv: nilValue}
+		if runInfo.options.Profiler != nil {
+			var frame *profileFrame
+			runInfo.ctx, frame = runInfo.options.Profiler.enterFunction(runInfo.ctx, funcName(funcExpr), funcExpr.Position())
+			defer runInfo.options.Profiler.exitFunction(frame)
+		}
+		if runInfo.options.Tracer != nil {
+			runInfo.options.Tracer.Trace(runInfo.ctx, &TraceEvent{Kind: TraceScriptCall, Pos: funcExpr.Position(), Name: funcName(funcExpr), Args: traceValues(in[1:])})
+			defer func() {
+				event := &TraceEvent{Kind: TraceScriptReturn, Pos: funcExpr.Position(), Name: funcName(funcExpr), Results: []interface{}{traceValue(runInfo.rv)}}
+				if runInfo.err != ErrReturn {
+					event.Err = runInfo.err
+				}
+				runInfo.options.Tracer.Trace(runInfo.ctx, event)
+			}()
+		}
 
 		// add Params to newEnv, except last Params
 		for i := 0; i < len(funcExpr.Params)-1; i++ {
@@ -57,11 +72,11 @@ func (runInfo *runInfoStruct) funcExpr() {
 		// run function statements
 		runInfo.runSingleStmt()
 		if runInfo.err != nil && runInfo.err != ErrReturn {
-			runInfo.err = newError(funcExpr, runInfo.err)
+			runInfo.err = newFunctionError(funcExpr, runInfo.err)
 			// return nil value and error
 			// need to do single reflect.ValueOf because nilValue is already reflect.Value of nil
 			// need to do double reflect.ValueOf of newError in order to match
-			return []reflect.Value{reflectValueNilValue, reflect.ValueOf(reflect.ValueOf(newError(funcExpr, runInfo.err)))}
+			return []reflect.Value{reflectValueNilValue, reflect.ValueOf(reflect.ValueOf(runInfo.err))}
 		}
 
 		// the reflect.ValueOf of rv is needed to work in the reflect.Value slice
@@ -74,7 +89,11 @@ func (runInfo *runInfoStruct) funcExpr() {
 
 	// if function name is not empty, define it in the env
 	if funcExpr.Name != "" {
-		runInfo.env.DefineValue(funcExpr.Name, runInfo.rv)
+		runInfo.err = runInfo.env.DefineValue(funcExpr.Name, runInfo.rv)
+		if runInfo.err != nil {
+			runInfo.err = newError(funcExpr, runInfo.err)
+			runInfo.rv = nilValue
+		}
 	}
 }
 
@@ -97,7 +116,11 @@ func (runInfo *runInfoStruct) anonCallExpr() {
 		return
 	}
 
-	runInfo.expr = &ast.CallExpr{Func: runInfo.rv, SubExprs: anonCallExpr.SubExprs, VarArg: anonCallExpr.VarArg, Go: anonCallExpr.Go}
+	callExpr := &ast.CallExpr{Func: runInfo.rv, SubExprs: anonCallExpr.SubExprs, VarArg: anonCallExpr.VarArg, Go: anonCallExpr.Go}
+	if runInfo.options.Tracer != nil {
+		callExpr.Name = traceCallName(anonCallExpr.Expr)
+	}
+	runInfo.expr = callExpr
 	runInfo.expr.SetPosition(anonCallExpr.Expr.Position())
 	runInfo.invokeExpr()
 }
@@ -140,9 +163,25 @@ func (runInfo *runInfoStruct) callExpr() {
 		return
 	}
 
+	// the args of a Go function start with the context when the call omits it
+	indexArgs := 0
+	if !isRunVMFunction && omitsContext(fType, callExpr) {
+		indexArgs = 1
+	}
+
+	if runInfo.options.Tracer != nil && !isRunVMFunction {
+		// deferred before the recover so it runs after and has the panic error
+		defer func() {
+			runInfo.traceGoCall(callExpr, f, args[indexArgs:], rvs)
+		}()
+	}
+
 	if !runInfo.options.Debug {
 		// captures panic
-		defer recoverFunc(runInfo)
+		defer recoverCallFunc(runInfo, callExpr)
+	} else if !isRunVMFunction {
+		// captures the errors of script functions called by the Go function, like a script handler called by ServeHTTP
+		defer recoverFunctionError(runInfo, callExpr)
 	}
 
 	runInfo.rv = nilValue
@@ -169,7 +208,12 @@ func (runInfo *runInfoStruct) callExpr() {
 		for i, expr := range callExpr.SubExprs {
 			if addrExpr, ok := expr.(*ast.AddrExpr); ok {
 				if identExpr, ok := addrExpr.Expr.(*ast.IdentExpr); ok {
-					runInfo.rv = args[i].Elem()
+					// the pointer can be in an interface argument, like a *bytes.Buffer as io.Writer
+					arg := args[i+indexArgs]
+					if arg.Kind() == reflect.Interface && !arg.IsNil() {
+						arg = arg.Elem()
+					}
+					runInfo.rv = arg.Elem()
 					runInfo.expr = identExpr
 					runInfo.invokeLetExpr()
 				}
@@ -179,6 +223,9 @@ func (runInfo *runInfoStruct) callExpr() {
 
 	// processCallReturnValues to get/convert return values to normal rv form
 	runInfo.rv, runInfo.err = processCallReturnValues(rvs, isRunVMFunction, true)
+	if runInfo.err != nil {
+		runInfo.addCallStack(callExpr)
+	}
 }
 
 // checkIfRunVMFunction checking the number and types of the reflect.Type.
@@ -206,27 +253,42 @@ func checkIfRunVMFunction(rt reflect.Type) bool {
 	return true
 }
 
+// omitsContext returns true if the Go function type rt has a first parameter of type context.Context
+// and the call has one argument less than the function needs, so the run context should be added.
+// For a variadic function that is only when the call has no variadic arguments.
+func omitsContext(rt reflect.Type, callExpr *ast.CallExpr) bool {
+	if rt.NumIn() < 1 || rt.In(0) != contextType || callExpr.VarArg {
+		return false
+	}
+	if rt.IsVariadic() {
+		return len(callExpr.SubExprs) == rt.NumIn()-2
+	}
+	return len(callExpr.SubExprs) == rt.NumIn()-1
+}
+
 // makeCallArgs creates the arguments reflect.Value slice for the four different kinds of functions.
 // Also returns true if CallSlice should be used on the arguments, or false if Call should be used.
 func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool, callExpr *ast.CallExpr) ([]reflect.Value, bool) {
 	// number of arguments
 	numInReal := rt.NumIn()
 	numIn := numInReal
-	if isRunVMFunction {
-		// for runVMFunction the first arg is context so does not count against number of SubExprs
+	// number of expressions
+	numExprs := len(callExpr.SubExprs)
+	// for runVMFunction first arg is always context,
+	// for Go functions the context is added when the first parameter is context and the call omits it
+	hasContext := isRunVMFunction || omitsContext(rt, callExpr)
+	if hasContext {
+		// the first arg is context so does not count against number of SubExprs
 		numIn--
 	}
 	if numIn < 1 {
 		// no arguments needed
-		if isRunVMFunction {
-			// for runVMFunction first arg is always context
+		if hasContext {
 			return []reflect.Value{reflect.ValueOf(runInfo.ctx)}, false
 		}
 		return []reflect.Value{}, false
 	}
 
-	// number of expressions
-	numExprs := len(callExpr.SubExprs)
 	// checks to short circuit wrong number of arguments
 	if (!rt.IsVariadic() && !callExpr.VarArg && numIn != numExprs) ||
 		(rt.IsVariadic() && callExpr.VarArg && (numIn < numExprs || numIn > numExprs+1)) ||
@@ -252,8 +314,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 	} else {
 		args = make([]reflect.Value, 0, numExprs)
 	}
-	if isRunVMFunction {
-		// for runVMFunction first arg is always context
+	if hasContext {
 		args = append(args, reflect.ValueOf(runInfo.ctx))
 		indexInReal++
 	}
@@ -268,7 +329,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 		if isRunVMFunction {
 			args = append(args, reflect.ValueOf(runInfo.rv))
 		} else {
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, rt.In(indexInReal))
 			if runInfo.err != nil {
 				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -296,7 +357,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 		if isRunVMFunction {
 			args = append(args, reflect.ValueOf(runInfo.rv))
 		} else {
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, rt.In(indexInReal))
 			if runInfo.err != nil {
 				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -331,7 +392,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 			if isRunVMFunction {
 				args = append(args, reflect.ValueOf(runInfo.rv.Index(indexSlice)))
 			} else {
-				runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv.Index(indexSlice), rt.In(indexInReal))
+				runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv.Index(indexSlice), rt.In(indexInReal))
 				if runInfo.err != nil {
 					runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 						"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -365,7 +426,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 		if isRunVMFunction {
 			args = append(args, reflect.ValueOf(runInfo.rv))
 		} else {
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, rt.In(indexInReal))
 			if runInfo.err != nil {
 				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -386,7 +447,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 			if runInfo.err != nil {
 				return nil, false
 			}
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, sliceType)
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, sliceType)
 			if runInfo.err != nil {
 				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -411,7 +472,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 	if runInfo.err != nil {
 		return nil, false
 	}
-	runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, sliceType)
+	runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, sliceType)
 	if runInfo.err != nil {
 		runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 			"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -2,6 +2,7 @@ package vm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"reflect"
 	"sync"
@@ -675,6 +676,10 @@ func TestFunctionConversions(t *testing.T) {
 			Input: map[string]interface{}{"a": func(b func() (bool, string)) (bool, string) {
 				return b()
 			}}, RunError: fmt.Errorf("function wants return type bool but received type string")},
+		{Script: `b = func(c){ return c }; c = a(b)`,
+			Input: map[string]interface{}{"a": func(b func(...int64) int64) int64 {
+				return b(1, 2)
+			}}, RunError: fmt.Errorf("function wants 1 arguments but received 2")},
 	}
 	runTests(t, tests, nil, &Options{Debug: false})
 }
@@ -689,6 +694,9 @@ func TestVariadicFunctionConversions(t *testing.T) {
 		}
 		return total
 	}
+	testCallVariadicFunc := func(f func(...int64) int64) int64 {
+		return f(1, 2)
+	}
 	tests := []Test{
 		// params Variadic arg !Variadic
 		{Script: `a(true)`, Input: map[string]interface{}{"a": func(b ...interface{}) []interface{} { return b }}, RunOutput: []interface{}{true}},
@@ -698,6 +706,10 @@ func TestVariadicFunctionConversions(t *testing.T) {
 		{Script: `a(1, 2)`, Input: map[string]interface{}{"a": testSumFunc}, RunOutput: int64(3)},
 		{Script: `a(1, 2, 3)`, Input: map[string]interface{}{"a": testSumFunc}, RunOutput: int64(6)},
 
+		// Go function calls variadic converted function
+		{Script: `a(func(b, c) { return b + c })`, Input: map[string]interface{}{"a": testCallVariadicFunc}, RunOutput: int64(3)},
+		{Script: `a(func(b...) { return len(b) })`, Input: map[string]interface{}{"a": testCallVariadicFunc}, RunOutput: int64(2)},
+
 		// TODO: add more tests
 	}
 	runTests(t, tests, nil, &Options{Debug: true})
@@ -797,6 +809,85 @@ func TestCallFunctionWithVararg(t *testing.T) {
 	}
 }
 
+func TestCallFunctionWithContext(t *testing.T) {
+	t.Parallel()
+
+	type key string
+	type funcMap map[string]interface{}
+	ctx := context.WithValue(context.Background(), key("a"), "b")
+	e := env.NewEnv()
+	for name, function := range map[string]interface{}{
+		"value":    func(ctx context.Context) interface{} { return ctx.Value(key("a")) },
+		"valueArg": func(ctx context.Context, s string) string { return s + ctx.Value(key("a")).(string) },
+		"valueVar": func(ctx context.Context, s ...string) int { return len(s) },
+		"empty":    func() context.Context { return context.Background() },
+		"setValue": func(ctx context.Context, p *int64) { *p = 2 },
+		"callFunc": func(f func() interface{}) interface{} { return f() },
+		"callMap": func(m funcMap) interface{} {
+			if f, ok := m["a"].(func(...interface{}) interface{}); ok {
+				return f()
+			}
+			return "not converted"
+		},
+	} {
+		err := e.Define(name, function)
+		if err != nil {
+			t.Fatalf("Define error: %v", err)
+		}
+	}
+
+	tests := []struct {
+		script   string
+		expected interface{}
+		err      string
+	}{
+		{script: `value()`, expected: "b"},
+		{script: `value(empty())`, expected: nil},
+		{script: `valueArg("a")`, expected: "ab"},
+		{script: `valueArg(empty(), "a")`, err: "interface conversion: interface {} is nil, not string"},
+		{script: `valueArg()`, err: "function wants 2 arguments but received 0"},
+		{script: `valueVar()`, expected: 0},
+		{script: `valueVar(empty(), "a", "b")`, expected: 2},
+		{script: `func f() { return value() }; f()`, expected: "b"},
+		{script: `a = 1; setValue(&a); a`, expected: int64(2)},
+		{script: `callFunc(func() { return value() })`, expected: "b"},
+		{script: `callMap({"a": func() { return value() }})`, expected: "not converted"},
+	}
+	for _, test := range tests {
+		value, err := ExecuteContext(ctx, e, nil, test.script)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("execute error - received: %v - expected: %v - script: %v", err, test.err, test.script)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("execute error - received: %v - expected: nil - script: %v", err, test.script)
+			continue
+		}
+		if !reflect.DeepEqual(value, test.expected) {
+			t.Errorf("execute value - received: %#v - expected: %#v - script: %v", value, test.expected, test.script)
+		}
+	}
+}
+
+func TestDebugGoFunctionPanic(t *testing.T) {
+	// Debug mode only recovers the errors of script functions called by Go functions
+	e := env.NewEnv()
+	err := e.Define("a", func() { panic(&Error{Message: "a"}) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	defer func() {
+		recoverInterface := recover()
+		if vmErr, ok := recoverInterface.(*Error); !ok || vmErr.Message != "a" {
+			t.Errorf("recover - received: %#v - expected: *Error a", recoverInterface)
+		}
+	}()
+	_, err = Execute(e, &Options{Debug: true}, "a()")
+	t.Errorf("execute error - received: %v - expected: panic", err)
+}
+
 func TestGoFunctionConcurrency(t *testing.T) {
 	t.Parallel()
 
@@ -861,3 +952,138 @@ waitGroup.Wait()`,
 
 	runTests(t, tests, nil, &Options{Debug: true})
 }
+
+func TestFuncOf(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	_, err := Execute(e, nil, `
+func add(a, b) { return a + b }
+func swap(a, b) { return b, a }
+func fail(a) { if a { throw "failed" }; return "ok" }
+func sleep() { for { } }
+b = 1
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	err = e.Define("goAdd", func(a int, b int) int { return a + b })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	_, err = FuncOf(e, "add", reflect.TypeOf(1))
+	expectedError := "type int is not a function type"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("FuncOf error - received: %v - expected: %v", err, expectedError)
+	}
+	_, err = FuncOf(e, "c", reflect.TypeOf(func() {}))
+	expectedError = "undefined symbol 'c'"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("FuncOf error - received: %v - expected: %v", err, expectedError)
+	}
+	_, err = FuncOf(e, "b", reflect.TypeOf(func() {}))
+	expectedError = "symbol 'b' is type int64 and not a function"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("FuncOf error - received: %v - expected: %v", err, expectedError)
+	}
+	_, err = FuncOf(e, "goAdd", reflect.TypeOf(func() {}))
+	expectedError = "function 'goAdd' of type func(int, int) int cannot be converted to type func()"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("FuncOf error - received: %v - expected: %v", err, expectedError)
+	}
+
+	rv, err := FuncOf(e, "goAdd", reflect.TypeOf(func(int, int) int { return 0 }))
+	if err != nil {
+		t.Fatal("FuncOf error:", err)
+	}
+	if result := rv.Interface().(func(int, int) int)(1, 2); result != 3 {
+		t.Errorf("goAdd - received: %v - expected: %v", result, 3)
+	}
+
+	rv, err = FuncOf(e, "add", reflect.TypeOf(func(int64, int64) int64 { return 0 }))
+	if err != nil {
+		t.Fatal("FuncOf error:", err)
+	}
+	if result := rv.Interface().(func(int64, int64) int64)(1, 2); result != 3 {
+		t.Errorf("add - received: %v - expected: %v", result, 3)
+	}
+
+	rv, err = FuncOf(e, "add", reflect.TypeOf(func(string, string) (string, error) { return "", nil }))
+	if err != nil {
+		t.Fatal("FuncOf error:", err)
+	}
+	if result, err := rv.Interface().(func(string, string) (string, error))("a", "b"); err != nil || result != "ab" {
+		t.Errorf("add - received: %v, %v - expected: %v, %v", result, err, "ab", nil)
+	}
+
+	rv, err = FuncOf(e, "swap", reflect.TypeOf(func(int64, string) (string, int64, error) { return "", 0, nil }))
+	if err != nil {
+		t.Fatal("FuncOf error:", err)
+	}
+	if a, b, err := rv.Interface().(func(int64, string) (string, int64, error))(1, "a"); err != nil || a != "a" || b != 1 {
+		t.Errorf("swap - received: %v, %v, %v - expected: %v, %v, %v", a, b, err, "a", 1, nil)
+	}
+
+	rv, err = FuncOf(e, "fail", reflect.TypeOf(func(bool) (string, error) { return "", nil }))
+	if err != nil {
+		t.Fatal("FuncOf error:", err)
+	}
+	fail := rv.Interface().(func(bool) (string, error))
+	if result, err := fail(false); err != nil || result != "ok" {
+		t.Errorf("fail - received: %v, %v - expected: %v, %v", result, err, "ok", nil)
+	}
+	if result, err := fail(true); err == nil || err.Error() != "failed" || result != "" {
+		t.Errorf("fail - received: %v, %v - expected: %v, %v", result, err, "", "failed")
+	}
+
+	rv, err = FuncOf(e, "add", reflect.TypeOf(func(int64, int64) (bool, error) { return false, nil }))
+	if err != nil {
+		t.Fatal("FuncOf error:", err)
+	}
+	if _, err := rv.Interface().(func(int64, int64) (bool, error))(1, 2); err == nil || err.Error() != "function wants return type bool but received type int64" {
+		t.Errorf("add - received: %v - expected: %v", err, "function wants return type bool but received type int64")
+	}
+
+	rv, err = FuncOf(e, "sleep", reflect.TypeOf(func(context.Context) error { return nil }))
+	if err != nil {
+		t.Fatal("FuncOf error:", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	err = rv.Interface().(func(context.Context) error)(ctx)
+	cancel()
+	if err == nil || err.Error() != ErrInterrupt.Error() {
+		t.Errorf("sleep - received: %v - expected: %v", err, ErrInterrupt)
+	}
+}
+
+func TestBind(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	_, err := Execute(e, nil, `func join(a, b) { return a + "-" + b }`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	var join func(ctx context.Context, a string, b string) (string, error)
+	err = Bind(e, "join", join)
+	expectedError := "funcPtr must be pointer to function but received type func(context.Context, string, string) (string, error)"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Bind error - received: %v - expected: %v", err, expectedError)
+	}
+	err = Bind(e, "join", &err)
+	expectedError = "funcPtr must be pointer to function but received type *error"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Bind error - received: %v - expected: %v", err, expectedError)
+	}
+
+	err = Bind(e, "join", &join)
+	if err != nil {
+		t.Fatal("Bind error:", err)
+	}
+	result, err := join(context.Background(), "a", "b")
+	if err != nil || result != "a-b" {
+		t.Errorf("join - received: %v, %v - expected: %v, %v", result, err, "a-b", nil)
+	}
+}
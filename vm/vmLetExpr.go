@@ -12,9 +12,18 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 	// IdentExpr
 	case *ast.IdentExpr:
-		if runInfo.env.SetValue(expr.Lit, runInfo.rv) != nil {
-			runInfo.err = nil
-			runInfo.env.DefineValue(expr.Lit, runInfo.rv)
+		runInfo.err = runInfo.env.SetValue(expr.Lit, runInfo.rv)
+		if runInfo.err != nil && runInfo.err != env.ErrConstant && runInfo.err != env.ErrFrozen {
+			// symbol not found, define it in current scope
+			runInfo.err = runInfo.env.DefineValue(expr.Lit, runInfo.rv)
+		}
+		if runInfo.err != nil {
+			runInfo.err = newError(expr, runInfo.err)
+			runInfo.rv = nilValue
+			return
+		}
+		if runInfo.options.Tracer != nil {
+			runInfo.traceVarWrite(expr, runInfo.env, expr.Lit, runInfo.rv)
 		}
 
 	// MemberExpr
@@ -36,6 +45,10 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 			if runInfo.err != nil {
 				runInfo.err = newError(expr, runInfo.err)
 				runInfo.rv = nilValue
+				return
+			}
+			if runInfo.options.Tracer != nil {
+				runInfo.options.Tracer.Trace(runInfo.ctx, &TraceEvent{Kind: TraceVarWrite, Pos: expr.Position(), Name: expr.Name, Scope: "module", Value: traceValue(value)})
 			}
 			return
 		}
@@ -48,13 +61,22 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 		// Struct
 		case reflect.Struct:
-			field, found := runInfo.rv.Type().FieldByName(expr.Name)
-			if !found {
-				runInfo.err = newStringError(expr, "no member named '"+expr.Name+"' for struct")
-				runInfo.rv = nilValue
-				return
+			if members := getTypeMembers(runInfo.rv.Type()); members != nil {
+				runInfo.rv, runInfo.err = members.field(runInfo.rv, expr.Name)
+				if runInfo.err != nil {
+					runInfo.err = newError(expr, runInfo.err)
+					runInfo.rv = nilValue
+					return
+				}
+			} else {
+				field, found := runInfo.rv.Type().FieldByName(expr.Name)
+				if !found {
+					runInfo.err = newStringError(expr, "no member named '"+expr.Name+"' for struct")
+					runInfo.rv = nilValue
+					return
+				}
+				runInfo.rv = runInfo.rv.FieldByIndex(field.Index)
 			}
-			runInfo.rv = runInfo.rv.FieldByIndex(field.Index)
 			// From reflect CanSet:
 			// A Value can be changed only if it is addressable and was not obtained by the use of unexported struct fields.
 			// Often a struct has to be passed as a pointer to be set
@@ -64,7 +86,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, runInfo.rv.Type())
+			value, runInfo.err = convertReflectValueToType(runInfo.ctx, value, runInfo.rv.Type())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+runInfo.rv.Type().String()+" for struct")
 				runInfo.rv = nilValue
@@ -76,7 +98,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 		// Map
 		case reflect.Map:
-			value, runInfo.err = convertReflectValueToType(value, runInfo.rv.Type().Elem())
+			value, runInfo.err = convertMapValueToType(runInfo.ctx, value, runInfo.rv.Type())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+runInfo.rv.Type().Elem().String()+" for map")
 				runInfo.rv = nilValue
@@ -135,7 +157,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 			if index == item.Len() {
 				// try to do automatic append
-				value, runInfo.err = convertReflectValueToType(value, item.Type().Elem())
+				value, runInfo.err = convertReflectValueToType(runInfo.ctx, value, item.Type().Elem())
 				if runInfo.err != nil {
 					runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().Elem().String()+" for slice index")
 					runInfo.rv = nilValue
@@ -161,7 +183,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, item.Type())
+			value, runInfo.err = convertReflectValueToType(runInfo.ctx, value, item.Type())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().String()+" for slice index")
 				runInfo.rv = nilValue
@@ -173,14 +195,14 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 		// Map
 		case reflect.Map:
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, item.Type().Key())
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, item.Type().Key())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "index type "+runInfo.rv.Type().String()+" cannot be used for map index type "+item.Type().Key().String())
 				runInfo.rv = nilValue
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, item.Type().Elem())
+			value, runInfo.err = convertMapValueToType(runInfo.ctx, value, item.Type())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().Elem().String()+" for map")
 				runInfo.rv = nilValue
@@ -211,7 +233,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, item.Type())
+			value, runInfo.err = convertReflectValueToType(runInfo.ctx, value, item.Type())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().String())
 				runInfo.rv = nilValue
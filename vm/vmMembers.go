@@ -0,0 +1,246 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"unicode"
+)
+
+// MemberOptions are the options for how the fields and methods of a Go type are exposed to scripts.
+// Struct fields can also be configured with the anko struct tag:
+//
+//	Field int `anko:"name"`          // exposed as name
+//	Field int `anko:"-"`             // hidden
+//	Field int `anko:"name,readonly"` // exposed as name and cannot be assigned
+type MemberOptions struct {
+	// Names renames fields and methods, from Go name to script name. A script name of "-" hides the member.
+	// Names has priority over struct tag names.
+	Names map[string]string
+	// ReadOnly is the Go names of the fields that cannot be assigned by scripts.
+	ReadOnly []string
+	// LowerCase adds an alias for every member with the leading upper case letters lowered, for example userID for UserID.
+	LowerCase bool
+}
+
+// typeMember is a field or method of a type as exposed to scripts
+type typeMember struct {
+	name     string
+	index    []int
+	readOnly bool
+}
+
+// typeMembers is the members of a type by script name
+type typeMembers map[string]typeMember
+
+var (
+	membersMutex   sync.Mutex
+	membersOptions = make(map[reflect.Type]*MemberOptions)
+	// membersCount is the number of types in membersOptions, read atomically by getTypeMembers
+	membersCount int32
+	// membersTypeCache is the typeMembers by reflect.Type, read without membersMutex
+	membersTypeCache sync.Map
+)
+
+// RegisterMembers sets the member options for typ. A pointer type registers its element type.
+// Registering nil options removes the options for typ.
+func RegisterMembers(typ reflect.Type, options *MemberOptions) {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	membersMutex.Lock()
+	if options == nil {
+		delete(membersOptions, typ)
+	} else {
+		membersOptions[typ] = options
+	}
+	atomic.StoreInt32(&membersCount, int32(len(membersOptions)))
+	// clear cache since registered types can be embedded in other types
+	membersTypeCache.Range(func(key interface{}, value interface{}) bool {
+		membersTypeCache.Delete(key)
+		return true
+	})
+	membersMutex.Unlock()
+}
+
+// getTypeMembers returns the members of type t.
+// Returns nil if the members of t are not customized, in which case the Go names are used.
+func getTypeMembers(t reflect.Type) typeMembers {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	// only structs can have struct tags, so other types only need a lookup when types are registered
+	if t.Kind() != reflect.Struct && atomic.LoadInt32(&membersCount) == 0 {
+		return nil
+	}
+
+	members, ok := membersTypeCache.Load(t)
+	if ok {
+		return members.(typeMembers)
+	}
+
+	membersMutex.Lock()
+	defer membersMutex.Unlock()
+	members, ok = membersTypeCache.Load(t)
+	if ok {
+		return members.(typeMembers)
+	}
+	newMembers := makeTypeMembers(t)
+	membersTypeCache.Store(t, newMembers)
+	return newMembers
+}
+
+// makeTypeMembers makes the members of type t, must be called with membersMutex locked
+func makeTypeMembers(t reflect.Type) typeMembers {
+	options := membersOptions[t]
+	if options == nil {
+		if t.Kind() != reflect.Struct || !hasStructTags(t, make(map[reflect.Type]struct{})) {
+			return nil
+		}
+		options = &MemberOptions{}
+	}
+
+	readOnly := make(map[string]struct{}, len(options.ReadOnly))
+	for _, name := range options.ReadOnly {
+		readOnly[name] = struct{}{}
+	}
+
+	scriptName := func(name string, tagName string) string {
+		if rename, ok := options.Names[name]; ok {
+			return rename
+		}
+		return tagName
+	}
+
+	members := make(typeMembers)
+
+	if t.Kind() == reflect.Struct {
+		for _, field := range structFields(t) {
+			name := scriptName(field.Name, field.tag.name)
+			if name == "-" {
+				continue
+			}
+			_, isReadOnly := readOnly[field.Name]
+			members[name] = typeMember{name: field.Name, index: field.Index, readOnly: field.tag.readOnly || isReadOnly}
+		}
+	}
+
+	if t.Kind() != reflect.Interface {
+		ptrType := reflect.PtrTo(t)
+		for i := 0; i < ptrType.NumMethod(); i++ {
+			method := ptrType.Method(i)
+			name := scriptName(method.Name, method.Name)
+			if name == "-" {
+				continue
+			}
+			if _, ok := members[name]; ok {
+				continue
+			}
+			members[name] = typeMember{name: method.Name}
+		}
+	}
+
+	if options.LowerCase {
+		for name, member := range members {
+			alias := lowerName(name)
+			if _, ok := members[alias]; !ok {
+				members[alias] = member
+			}
+		}
+	}
+
+	return members
+}
+
+// hasStructTags returns true if struct type t or any of its embedded structs have anko struct tags
+func hasStructTags(t reflect.Type, visited map[reflect.Type]struct{}) bool {
+	if _, ok := visited[t]; ok {
+		return false
+	}
+	visited[t] = struct{}{}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if _, ok := field.Tag.Lookup("anko"); ok {
+			return true
+		}
+		if !field.Anonymous {
+			continue
+		}
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct && hasStructTags(fieldType, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// lowerName returns name with the leading upper case letters lowered, keeping the start of the next word.
+// For example: UserID to userID, URLPath to urlPath, ID to id
+func lowerName(name string) string {
+	runes := []rune(name)
+	for i := 0; i < len(runes) && unicode.IsUpper(runes[i]); i++ {
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// value returns the member name of rv
+func (members typeMembers) value(ctx context.Context, rv reflect.Value, name string) (reflect.Value, error) {
+	member, found := members[name]
+	if found && member.index == nil {
+		method := rv.MethodByName(member.name)
+		if method.IsValid() {
+			return method, nil
+		}
+		if rv.Kind() != reflect.Ptr && rv.CanAddr() {
+			return rv.Addr().MethodByName(member.name), nil
+		}
+		return nilValue, fmt.Errorf("method '%v' requires a pointer to %v", name, rv.Type())
+	}
+
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		if !found {
+			return nilValue, fmt.Errorf("no member named '%v' for struct", name)
+		}
+		field, ok := fieldByIndexNoAlloc(rv, member.index)
+		if !ok {
+			return nilValue, fmt.Errorf("struct member '%v' is in a nil embedded struct", name)
+		}
+		return field, nil
+	case reflect.Map:
+		return getMapIndex(ctx, reflect.ValueOf(name), rv), nil
+	}
+	return nilValue, fmt.Errorf("type %v does not support member operation", rv.Kind())
+}
+
+// field returns the struct field name of rv to be assigned
+func (members typeMembers) field(rv reflect.Value, name string) (reflect.Value, error) {
+	member, found := members[name]
+	if !found || member.index == nil {
+		return nilValue, fmt.Errorf("no member named '%v' for struct", name)
+	}
+	if member.readOnly {
+		return nilValue, fmt.Errorf("struct member '%v' is read-only", name)
+	}
+	field, err := fieldByIndexAlloc(rv, member.index)
+	if err != nil {
+		return nilValue, fmt.Errorf("struct member '%v' cannot be assigned", name)
+	}
+	return field, nil
+}
@@ -1,9 +1,12 @@
 package vm
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/mattn/anko/env"
 )
 
 func TestBasicOperators(t *testing.T) {
@@ -13,6 +16,8 @@ func TestBasicOperators(t *testing.T) {
 		{Script: `]`, ParseError: fmt.Errorf("syntax error")},
 
 		{Script: `2 + 1`, RunOutput: int64(3)},
+		{Script: "2 +\n1", RunOutput: int64(3)},
+		{Script: "a = 2 *\n\n3; a", RunOutput: int64(6)},
 		{Script: `2 - 1`, RunOutput: int64(1)},
 		{Script: `2 * 1`, RunOutput: int64(2)},
 		{Script: `2 / 1`, RunOutput: float64(2)},
@@ -856,6 +861,64 @@ func TestForLoop(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+// testIterator is an iterator over values that fails at the end if err is set
+type testIterator struct {
+	values []interface{}
+	index  int
+	err    error
+}
+
+func (iter *testIterator) Next() bool {
+	iter.index++
+	return iter.index <= len(iter.values)
+}
+
+func (iter *testIterator) Value() interface{} {
+	return iter.values[iter.index-1]
+}
+
+func (iter *testIterator) Err() error {
+	return iter.err
+}
+
+func TestForLoopIterator(t *testing.T) {
+	t.Parallel()
+
+	newIterator := func(err error) *testIterator {
+		return &testIterator{values: []interface{}{int64(1), nil, "c"}, err: err}
+	}
+	tests := []Test{
+		{Script: `b = []; for c in a { b += c }; b`, Input: map[string]interface{}{"a": newIterator(nil)}, RunOutput: []interface{}{int64(1), nil, "c"}},
+		{Script: `b = []; for c in a { if c == nil { continue }; b += c }; b`, Input: map[string]interface{}{"a": newIterator(nil)}, RunOutput: []interface{}{int64(1), "c"}},
+		{Script: `b = []; for c in a { if c == nil { break }; b += c }; b`, Input: map[string]interface{}{"a": newIterator(fmt.Errorf("d"))}, RunOutput: []interface{}{int64(1)}},
+		{Script: `func b() { for c in a { if c == "c" { return c } } }; b()`, Input: map[string]interface{}{"a": newIterator(nil)}, RunOutput: "c"},
+		{Script: `for c in a { }`, Input: map[string]interface{}{"a": newIterator(fmt.Errorf("d"))}, RunError: fmt.Errorf("d")},
+		{Script: `for c in a { 1++ }`, Input: map[string]interface{}{"a": newIterator(fmt.Errorf("d"))}, RunError: fmt.Errorf("invalid operation")},
+		{Script: `for c, d in a { }`, Input: map[string]interface{}{"a": newIterator(nil)}, RunError: fmt.Errorf("for cannot loop over type *vm.testIterator with more than one variable")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	iter := newIterator(nil)
+	e := env.NewEnv()
+	err := e.Define("a", iter)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.Define("cancel", cancel)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = ExecuteContext(ctx, e, nil, `for c in a { cancel() }`)
+	if err != ErrInterrupt {
+		t.Errorf("execute error - received: %v - expected: %v", err, ErrInterrupt)
+	}
+	if iter.index != 1 {
+		t.Errorf("Next calls - received: %v - expected: 1", iter.index)
+	}
+}
+
 func TestItemInList(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,299 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mattn/anko/ast"
+)
+
+// Profiler records the wall time, executions and allocations of script lines and the calls of script functions.
+// Set it in Options to profile runs. A Profiler can be used by many runs at the same time.
+//
+// Wall time and allocations of a line do not include the nested statements and the called script functions,
+// they are attributed to the lines of those. Allocations are measured by the Go runtime for the whole program,
+// so allocations by other goroutines running at the same time are included.
+type Profiler struct {
+	mutex     sync.Mutex
+	filename  string
+	start     time.Time
+	functions map[string]*ProfileFunction
+	samples   map[string]*profileSample
+}
+
+// ProfileFunction is the profile of a script function.
+type ProfileFunction struct {
+	// Name is the function name, func@line:column for anonymous functions and main for the script top level.
+	Name string
+	// Pos is the position of the function definition.
+	Pos ast.Position
+	// Calls is the number of calls.
+	Calls int64
+	// Wall is the wall time of the calls, including the called functions.
+	Wall time.Duration
+	// AllocBytes is the number of bytes allocated by the calls, including the called functions.
+	AllocBytes int64
+	// AllocObjects is the number of objects allocated by the calls, including the called functions.
+	AllocObjects int64
+}
+
+// ProfileLine is the profile of a script line, that is the statement at a position in a function.
+type ProfileLine struct {
+	// Function is the name of the function the statement is in.
+	Function string
+	// Pos is the position of the statement.
+	Pos ast.Position
+	// Count is the number of times the statement was run.
+	Count int64
+	// Wall is the wall time of the statement, not including nested statements and called functions.
+	Wall time.Duration
+	// AllocBytes is the number of bytes allocated by the statement, not including nested statements and called functions.
+	AllocBytes int64
+	// AllocObjects is the number of objects allocated by the statement, not including nested statements and called functions.
+	AllocObjects int64
+}
+
+// profileLocation is a line in a function
+type profileLocation struct {
+	function string
+	funcPos  ast.Position
+	pos      ast.Position
+}
+
+// profileSample are the totals of a call stack, leaf first
+type profileSample struct {
+	stack        []profileLocation
+	count        int64
+	wall         int64
+	allocBytes   int64
+	allocObjects int64
+}
+
+// profileFrame is a running call of a script function
+type profileFrame struct {
+	parent     *profileFrame
+	function   string
+	funcPos    ast.Position
+	callPos    ast.Position
+	callerStmt *profileStmt
+	start      time.Time
+	startBytes uint64
+	startObjs  uint64
+
+	// stmt is the innermost running statement, guarded by mutex because go calls read it from another goroutine
+	mutex sync.Mutex
+	stmt  *profileStmt
+}
+
+// profileStmt is a running statement
+type profileStmt struct {
+	parent     *profileStmt
+	previous   *profileStmt
+	pos        ast.Position
+	start      time.Time
+	startBytes uint64
+	startObjs  uint64
+
+	// totals of the nested statements, updated atomically
+	childWall    int64
+	childBytes   int64
+	childObjects int64
+}
+
+// profileFrameKey is the context key of the running profileFrame
+type profileFrameKey struct{}
+
+// NewProfiler returns a new Profiler. The filename is used for the script lines in pprof profiles.
+func NewProfiler(filename string) *Profiler {
+	return &Profiler{
+		filename:  filename,
+		start:     time.Now(),
+		functions: make(map[string]*ProfileFunction),
+		samples:   make(map[string]*profileSample),
+	}
+}
+
+// Functions returns the profiles of the called script functions, by descending wall time.
+func (profiler *Profiler) Functions() []ProfileFunction {
+	profiler.mutex.Lock()
+	functions := make([]ProfileFunction, 0, len(profiler.functions))
+	for _, function := range profiler.functions {
+		functions = append(functions, *function)
+	}
+	profiler.mutex.Unlock()
+
+	sort.Slice(functions, func(i, j int) bool {
+		if functions[i].Wall != functions[j].Wall {
+			return functions[i].Wall > functions[j].Wall
+		}
+		return functions[i].Name < functions[j].Name
+	})
+	return functions
+}
+
+// Lines returns the profiles of the script lines that were run, by descending wall time.
+func (profiler *Profiler) Lines() []ProfileLine {
+	lineMap := make(map[profileLocation]*ProfileLine)
+	profiler.mutex.Lock()
+	for _, sample := range profiler.samples {
+		location := sample.stack[0]
+		line, ok := lineMap[location]
+		if !ok {
+			line = &ProfileLine{Function: location.function, Pos: location.pos}
+			lineMap[location] = line
+		}
+		line.Count += sample.count
+		line.Wall += time.Duration(sample.wall)
+		line.AllocBytes += sample.allocBytes
+		line.AllocObjects += sample.allocObjects
+	}
+	profiler.mutex.Unlock()
+
+	lines := make([]ProfileLine, 0, len(lineMap))
+	for _, line := range lineMap {
+		lines = append(lines, *line)
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		if lines[i].Wall != lines[j].Wall {
+			return lines[i].Wall > lines[j].Wall
+		}
+		if lines[i].Pos.Line != lines[j].Pos.Line {
+			return lines[i].Pos.Line < lines[j].Pos.Line
+		}
+		return lines[i].Pos.Column < lines[j].Pos.Column
+	})
+	return lines
+}
+
+// enterFunction starts profiling a call of a script function, returning the context of the call
+func (profiler *Profiler) enterFunction(ctx context.Context, function string, funcPos ast.Position) (context.Context, *profileFrame) {
+	frame := &profileFrame{function: function, funcPos: funcPos}
+	if parent, ok := ctx.Value(profileFrameKey{}).(*profileFrame); ok {
+		frame.parent = parent
+		parent.mutex.Lock()
+		frame.callerStmt = parent.stmt
+		parent.mutex.Unlock()
+		if frame.callerStmt != nil {
+			frame.callPos = frame.callerStmt.pos
+		}
+	}
+	frame.startBytes, frame.startObjs = readAllocs()
+	frame.start = time.Now()
+	return context.WithValue(ctx, profileFrameKey{}, frame), frame
+}
+
+// exitFunction ends profiling the call of a script function
+func (profiler *Profiler) exitFunction(frame *profileFrame) {
+	wall := time.Since(frame.start)
+	bytes, objects := readAllocs()
+
+	key := fmt.Sprintf("%v@%v:%v", frame.function, frame.funcPos.Line, frame.funcPos.Column)
+	profiler.mutex.Lock()
+	function, ok := profiler.functions[key]
+	if !ok {
+		function = &ProfileFunction{Name: frame.function, Pos: frame.funcPos}
+		profiler.functions[key] = function
+	}
+	function.Calls++
+	// for recursive calls only the outermost call counts for the totals
+	recursive := false
+	for parent := frame.parent; parent != nil; parent = parent.parent {
+		if parent.function == frame.function && parent.funcPos == frame.funcPos {
+			recursive = true
+			break
+		}
+	}
+	if !recursive {
+		function.Wall += wall
+		function.AllocBytes += int64(bytes - frame.startBytes)
+		function.AllocObjects += int64(objects - frame.startObjs)
+	}
+	profiler.mutex.Unlock()
+}
+
+// startStmt starts profiling a statement, returning nil if the context has no running function
+func (profiler *Profiler) startStmt(ctx context.Context, stmt ast.Stmt) (*profileFrame, *profileStmt) {
+	frame, ok := ctx.Value(profileFrameKey{}).(*profileFrame)
+	if !ok {
+		return nil, nil
+	}
+
+	record := &profileStmt{pos: stmt.Position()}
+	frame.mutex.Lock()
+	record.previous = frame.stmt
+	record.parent = frame.stmt
+	if record.parent == nil {
+		record.parent = frame.callerStmt
+	}
+	frame.stmt = record
+	frame.mutex.Unlock()
+
+	record.startBytes, record.startObjs = readAllocs()
+	record.start = time.Now()
+	return frame, record
+}
+
+// endStmt ends profiling a statement, adding its totals to the sample of the call stack
+func (profiler *Profiler) endStmt(frame *profileFrame, record *profileStmt) {
+	wall := int64(time.Since(record.start))
+	bytes, objects := readAllocs()
+	allocBytes := int64(bytes - record.startBytes)
+	allocObjects := int64(objects - record.startObjs)
+
+	frame.mutex.Lock()
+	frame.stmt = record.previous
+	frame.mutex.Unlock()
+
+	if record.parent != nil {
+		atomic.AddInt64(&record.parent.childWall, wall)
+		atomic.AddInt64(&record.parent.childBytes, allocBytes)
+		atomic.AddInt64(&record.parent.childObjects, allocObjects)
+	}
+
+	selfWall := nonNegative(wall - atomic.LoadInt64(&record.childWall))
+	selfBytes := nonNegative(allocBytes - atomic.LoadInt64(&record.childBytes))
+	selfObjects := nonNegative(allocObjects - atomic.LoadInt64(&record.childObjects))
+
+	var builder strings.Builder
+	stack := []profileLocation{{function: frame.function, funcPos: frame.funcPos, pos: record.pos}}
+	fmt.Fprintf(&builder, "%v@%v:%v", frame.function, frame.funcPos, record.pos)
+	for callee := frame; callee.parent != nil; callee = callee.parent {
+		parent := callee.parent
+		stack = append(stack, profileLocation{function: parent.function, funcPos: parent.funcPos, pos: callee.callPos})
+		fmt.Fprintf(&builder, ";%v@%v:%v", parent.function, parent.funcPos, callee.callPos)
+	}
+	key := builder.String()
+
+	profiler.mutex.Lock()
+	sample, ok := profiler.samples[key]
+	if !ok {
+		sample = &profileSample{stack: stack}
+		profiler.samples[key] = sample
+	}
+	sample.count++
+	sample.wall += selfWall
+	sample.allocBytes += selfBytes
+	sample.allocObjects += selfObjects
+	profiler.mutex.Unlock()
+}
+
+// funcName returns the profile name of a script function
+func funcName(funcExpr *ast.FuncExpr) string {
+	if funcExpr.Name != "" {
+		return funcExpr.Name
+	}
+	pos := funcExpr.Position()
+	return fmt.Sprintf("func@%v:%v", pos.Line, pos.Column)
+}
+
+func nonNegative(i int64) int64 {
+	if i < 0 {
+		return 0
+	}
+	return i
+}
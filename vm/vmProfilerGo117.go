@@ -0,0 +1,22 @@
+// +build go1.17
+
+package vm
+
+import (
+	"runtime/metrics"
+)
+
+// readAllocs returns the total number of bytes and objects allocated by the program.
+// runtime/metrics reads them without stopping the world.
+func readAllocs() (uint64, uint64) {
+	samples := []metrics.Sample{{Name: "/gc/heap/allocs:bytes"}, {Name: "/gc/heap/allocs:objects"}}
+	metrics.Read(samples)
+	var bytes, objects uint64
+	if samples[0].Value.Kind() == metrics.KindUint64 {
+		bytes = samples[0].Value.Uint64()
+	}
+	if samples[1].Value.Kind() == metrics.KindUint64 {
+		objects = samples[1].Value.Uint64()
+	}
+	return bytes, objects
+}
@@ -0,0 +1,14 @@
+// +build !go1.17
+
+package vm
+
+import (
+	"runtime"
+)
+
+// readAllocs returns the total number of bytes and objects allocated by the program
+func readAllocs() (uint64, uint64) {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	return memStats.TotalAlloc, memStats.Mallocs
+}
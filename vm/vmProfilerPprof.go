@@ -0,0 +1,168 @@
+package vm
+
+import (
+	"compress/gzip"
+	"io"
+	"sort"
+	"time"
+)
+
+// profileFunctionKey is a script function in a pprof profile
+type profileFunctionKey struct {
+	name string
+	line int
+}
+
+// WritePprof writes the profile in the gzip compressed protocol buffer format of pprof.
+// The samples are the call stacks of script lines, with the values samples/count, wall/nanoseconds,
+// alloc_space/bytes and alloc_objects/count. The script functions and lines are named after
+// the filename given to NewProfiler, so go tool pprof can show them with the script source.
+func (profiler *Profiler) WritePprof(w io.Writer) error {
+	var profile protoBuffer
+	stringIndexes := map[string]int64{}
+	stringTable := []string{}
+	stringIndex := func(s string) int64 {
+		index, ok := stringIndexes[s]
+		if !ok {
+			index = int64(len(stringTable))
+			stringIndexes[s] = index
+			stringTable = append(stringTable, s)
+		}
+		return index
+	}
+	stringIndex("")
+
+	valueType := func(valueType string, unit string) []byte {
+		var buffer protoBuffer
+		buffer.int64Field(1, stringIndex(valueType))
+		buffer.int64Field(2, stringIndex(unit))
+		return buffer.data
+	}
+	profile.bytesField(1, valueType("samples", "count"))
+	profile.bytesField(1, valueType("wall", "nanoseconds"))
+	profile.bytesField(1, valueType("alloc_space", "bytes"))
+	profile.bytesField(1, valueType("alloc_objects", "count"))
+
+	profiler.mutex.Lock()
+	keys := make([]string, 0, len(profiler.samples))
+	for key := range profiler.samples {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	functionIDs := map[profileFunctionKey]uint64{}
+	locationIDs := map[profileLocation]uint64{}
+	var functions, locations []byte
+	for _, key := range keys {
+		sample := profiler.samples[key]
+		locationIDList := make([]uint64, 0, len(sample.stack))
+		for _, location := range sample.stack {
+			locationID, ok := locationIDs[location]
+			if !ok {
+				functionKey := profileFunctionKey{name: location.function, line: location.funcPos.Line}
+				functionID, ok := functionIDs[functionKey]
+				if !ok {
+					functionID = uint64(len(functionIDs) + 1)
+					functionIDs[functionKey] = functionID
+					var function protoBuffer
+					function.uint64Field(1, functionID)
+					function.int64Field(2, stringIndex(location.function))
+					function.int64Field(3, stringIndex(location.function))
+					function.int64Field(4, stringIndex(profiler.filename))
+					function.int64Field(5, int64(location.funcPos.Line))
+					functions = appendField(functions, 5, function.data)
+				}
+
+				locationID = uint64(len(locationIDs) + 1)
+				locationIDs[location] = locationID
+				var line protoBuffer
+				line.uint64Field(1, functionID)
+				line.int64Field(2, int64(location.pos.Line))
+				line.int64Field(3, int64(location.pos.Column))
+				var protoLocation protoBuffer
+				protoLocation.uint64Field(1, locationID)
+				protoLocation.bytesField(4, line.data)
+				locations = appendField(locations, 4, protoLocation.data)
+			}
+			locationIDList = append(locationIDList, locationID)
+		}
+
+		var protoSample protoBuffer
+		protoSample.packedField(1, locationIDList)
+		protoSample.packedField(2, []uint64{uint64(sample.count), uint64(sample.wall), uint64(sample.allocBytes), uint64(sample.allocObjects)})
+		profile.bytesField(2, protoSample.data)
+	}
+	profiler.mutex.Unlock()
+
+	profile.data = append(profile.data, locations...)
+	profile.data = append(profile.data, functions...)
+
+	// string indexes must be taken before writing the string table
+	periodType := valueType("wall", "nanoseconds")
+	defaultSampleType := stringIndex("wall")
+	for _, s := range stringTable {
+		profile.bytesField(6, []byte(s))
+	}
+	profile.int64Field(9, profiler.start.UnixNano())
+	profile.int64Field(10, int64(time.Since(profiler.start)))
+	profile.bytesField(11, periodType)
+	profile.int64Field(12, 1)
+	profile.int64Field(14, defaultSampleType)
+
+	writer := gzip.NewWriter(w)
+	_, err := writer.Write(profile.data)
+	if err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+// protoBuffer encodes protocol buffer fields
+type protoBuffer struct {
+	data []byte
+}
+
+func (buffer *protoBuffer) varint(x uint64) {
+	for x >= 0x80 {
+		buffer.data = append(buffer.data, byte(x)|0x80)
+		x >>= 7
+	}
+	buffer.data = append(buffer.data, byte(x))
+}
+
+// uint64Field adds a varint field, zero values are left out
+func (buffer *protoBuffer) uint64Field(field int, x uint64) {
+	if x == 0 {
+		return
+	}
+	buffer.varint(uint64(field) << 3)
+	buffer.varint(x)
+}
+
+// int64Field adds a varint field, zero values are left out
+func (buffer *protoBuffer) int64Field(field int, x int64) {
+	buffer.uint64Field(field, uint64(x))
+}
+
+// bytesField adds a length delimited field
+func (buffer *protoBuffer) bytesField(field int, data []byte) {
+	buffer.varint(uint64(field)<<3 | 2)
+	buffer.varint(uint64(len(data)))
+	buffer.data = append(buffer.data, data...)
+}
+
+// packedField adds a packed repeated varint field
+func (buffer *protoBuffer) packedField(field int, xs []uint64) {
+	var packed protoBuffer
+	for _, x := range xs {
+		packed.varint(x)
+	}
+	buffer.bytesField(field, packed.data)
+}
+
+// appendField appends a length delimited field to data
+func appendField(data []byte, field int, fieldData []byte) []byte {
+	buffer := protoBuffer{data: data}
+	buffer.bytesField(field, fieldData)
+	return buffer.data
+}
@@ -0,0 +1,128 @@
+package vm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+)
+
+func TestProfiler(t *testing.T) {
+	t.Parallel()
+
+	script := `
+func fib(n) {
+	if n < 2 {
+		return n
+	}
+	return fib(n - 1) + fib(n - 2)
+}
+a = []
+for i = 0; i < 10; i++ {
+	a += [fib(i)]
+}
+f = func(x) { return x * 2 }
+f(1)
+`
+	stmt, err := parser.ParseSrc(script)
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	profiler := NewProfiler("test.ank")
+	_, err = RunContext(context.Background(), env.NewEnv(), &Options{Profiler: profiler}, stmt)
+	if err != nil {
+		t.Fatal("RunContext error:", err)
+	}
+
+	calls := make(map[string]int64)
+	for _, function := range profiler.Functions() {
+		calls[function.Name] = function.Calls
+		if function.Wall <= 0 {
+			t.Errorf("function %v wall time is not positive: %v", function.Name, function.Wall)
+		}
+	}
+	// fib(n) is called fib(n+2)*2-1 times, summed for n from 0 to 9
+	expectedCalls := map[string]int64{"main": 1, "fib": 276, "func@12:5": 1}
+	for name, expected := range expectedCalls {
+		if calls[name] != expected {
+			t.Errorf("calls of %v - received: %v - expected: %v", name, calls[name], expected)
+		}
+	}
+	if len(calls) != len(expectedCalls) {
+		t.Errorf("functions - received: %v - expected: %v", calls, expectedCalls)
+	}
+
+	counts := make(map[int]int64)
+	for _, line := range profiler.Lines() {
+		counts[line.Pos.Line] += line.Count
+		if line.Wall < 0 || line.AllocBytes < 0 || line.AllocObjects < 0 {
+			t.Errorf("line %v has negative values: %+v", line.Pos.Line, line)
+		}
+	}
+	expectedCounts := map[int]int64{3: 276, 4: 143, 6: 133, 8: 1, 9: 2, 10: 10, 12: 2, 13: 1}
+	for line, expected := range expectedCounts {
+		if counts[line] != expected {
+			t.Errorf("count of line %v - received: %v - expected: %v", line, counts[line], expected)
+		}
+	}
+
+	var buffer bytes.Buffer
+	err = profiler.WritePprof(&buffer)
+	if err != nil {
+		t.Fatal("WritePprof error:", err)
+	}
+	reader, err := gzip.NewReader(&buffer)
+	if err != nil {
+		t.Fatal("gzip NewReader error:", err)
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal("ReadAll error:", err)
+	}
+	for _, s := range []string{"wall", "nanoseconds", "alloc_space", "test.ank", "fib", "func@12:5", "main"} {
+		if !bytes.Contains(data, []byte(s)) {
+			t.Errorf("profile does not contain the string %q", s)
+		}
+	}
+}
+
+func TestProfilerGoroutines(t *testing.T) {
+	t.Parallel()
+
+	script := `
+func work(c, n) {
+	s = 0
+	for i = 0; i < n; i++ {
+		s += i
+	}
+	c <- s
+}
+c = make(chan int64)
+for i = 0; i < 4; i++ {
+	go work(c, 100)
+}
+for i = 0; i < 4; i++ {
+	<- c
+}
+`
+	stmt, err := parser.ParseSrc(script)
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	profiler := NewProfiler("test.ank")
+	_, err = RunContext(context.Background(), env.NewEnv(), &Options{Profiler: profiler}, stmt)
+	if err != nil {
+		t.Fatal("RunContext error:", err)
+	}
+
+	// the calls of work can still be running, but the lines before sending have ended
+	for _, line := range profiler.Lines() {
+		if line.Pos.Line == 5 && line.Count != 400 {
+			t.Errorf("count of line 5 - received: %v - expected: 400", line.Count)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package vm
 import (
 	"context"
 	"fmt"
+	"io"
 	"reflect"
 
 	"github.com/mattn/anko/ast"
@@ -41,6 +42,13 @@ func RunContext(ctx context.Context, env *env.Env, options *Options, stmt ast.St
 	if runInfo.options == nil {
 		runInfo.options = &Options{}
 	}
+	if runInfo.options.Profiler != nil {
+		if _, ok := ctx.Value(profileFrameKey{}).(*profileFrame); !ok {
+			var frame *profileFrame
+			runInfo.ctx, frame = runInfo.options.Profiler.enterFunction(ctx, "main", ast.Position{})
+			defer runInfo.options.Profiler.exitFunction(frame)
+		}
+	}
 	runInfo.runSingleStmt()
 	if runInfo.err == ErrReturn {
 		runInfo.err = nil
@@ -58,6 +66,23 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 	default:
 	}
 
+	if (runInfo.options.Profiler != nil || runInfo.options.Coverage != nil || runInfo.options.Tracer != nil) && runInfo.stmt != nil {
+		if _, ok := runInfo.stmt.(*ast.StmtsStmt); !ok {
+			if runInfo.options.Coverage != nil {
+				runInfo.options.Coverage.countStatement(runInfo.stmt)
+			}
+			if runInfo.options.Profiler != nil {
+				frame, record := runInfo.options.Profiler.startStmt(runInfo.ctx, runInfo.stmt)
+				if record != nil {
+					defer runInfo.options.Profiler.endStmt(frame, record)
+				}
+			}
+			if runInfo.options.Tracer != nil {
+				defer runInfo.traceStmtEnter(runInfo.stmt)()
+			}
+		}
+	}
+
 	switch stmt := runInfo.stmt.(type) {
 
 	// nil
@@ -97,45 +122,11 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 	// VarStmt
 	case *ast.VarStmt:
-		// get right side expression values
-		rvs := make([]reflect.Value, len(stmt.Exprs))
-		var i int
-		for i, runInfo.expr = range stmt.Exprs {
-			runInfo.invokeExpr()
-			if runInfo.err != nil {
-				return
-			}
-			if env, ok := runInfo.rv.Interface().(*env.Env); ok {
-				rvs[i] = reflect.ValueOf(env.DeepCopy())
-			} else {
-				rvs[i] = runInfo.rv
-			}
-		}
-
-		if len(rvs) == 1 && len(stmt.Names) > 1 {
-			// only one right side value but many left side names
-			value := rvs[0]
-			if value.Kind() == reflect.Interface && !value.IsNil() {
-				value = value.Elem()
-			}
-			if (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) && value.Len() > 0 {
-				// value is slice/array, add each value to left side names
-				for i := 0; i < value.Len() && i < len(stmt.Names); i++ {
-					runInfo.env.DefineValue(stmt.Names[i], value.Index(i))
-				}
-				// return last value of slice/array
-				runInfo.rv = value.Index(value.Len() - 1)
-				return
-			}
-		}
+		runInfo.defineNames(stmt, stmt.Names, stmt.Exprs, runInfo.env.DefineValue)
 
-		// define all names with right side values
-		for i = 0; i < len(rvs) && i < len(stmt.Names); i++ {
-			runInfo.env.DefineValue(stmt.Names[i], rvs[i])
-		}
-
-		// return last right side value
-		runInfo.rv = rvs[len(rvs)-1]
+	// ConstStmt
+	case *ast.ConstStmt:
+		runInfo.defineNames(stmt, stmt.Names, stmt.Exprs, runInfo.env.DefineConstValue)
 
 	// LetsStmt
 	case *ast.LetsStmt:
@@ -232,6 +223,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 		if toBool(runInfo.rv) {
 			// then
+			if runInfo.options.Coverage != nil {
+				runInfo.options.Coverage.countBranch(stmt, 0)
+			}
 			runInfo.rv = nilValue
 			runInfo.stmt = stmt.Then
 			runInfo.env = env.NewEnv()
@@ -240,7 +234,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			return
 		}
 
-		for _, statement := range stmt.ElseIf {
+		for i, statement := range stmt.ElseIf {
 			elseIf := statement.(*ast.IfStmt)
 
 			// else if - if
@@ -257,6 +251,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			}
 
 			// else if - then
+			if runInfo.options.Coverage != nil {
+				runInfo.options.Coverage.countBranch(stmt, i+1)
+			}
 			runInfo.rv = nilValue
 			runInfo.stmt = elseIf.Then
 			runInfo.env = env.NewEnv()
@@ -265,6 +262,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			return
 		}
 
+		if runInfo.options.Coverage != nil {
+			runInfo.options.Coverage.countBranch(stmt, len(stmt.ElseIf)+1)
+		}
 		if stmt.Else != nil {
 			// else
 			runInfo.rv = nilValue
@@ -375,6 +375,73 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		env := runInfo.env
 		runInfo.env = env.NewEnv()
 
+		var iter iterator
+		if value.IsValid() && value.CanInterface() {
+			switch typedValue := value.Interface().(type) {
+			case iterator:
+				iter = typedValue
+			case textScanner:
+				iter = textScannerIterator{typedValue}
+			case rowsScanner:
+				iter = &rowsScannerIterator{rowsScanner: typedValue}
+			}
+		}
+		if iter != nil {
+			if closer, ok := iter.(io.Closer); ok {
+				// close the rows, or another iterator with Close, when the loop ends
+				defer closer.Close()
+			}
+			if len(stmt.Vars) > 1 {
+				runInfo.err = newStringError(stmt, "for cannot loop over type "+value.Type().String()+" with more than one variable")
+				runInfo.rv = nilValue
+				runInfo.env = env
+				return
+			}
+
+			for {
+				select {
+				case <-runInfo.ctx.Done():
+					runInfo.err = ErrInterrupt
+					runInfo.rv = nilValue
+					runInfo.env = env
+					return
+				default:
+				}
+
+				if !iter.Next() {
+					// the error of the iterator is only for when it stops
+					runInfo.err = newError(stmt, iter.Err())
+					break
+				}
+
+				iv := reflect.ValueOf(iter.Value())
+				if !iv.IsValid() {
+					iv = nilValue
+				}
+				runInfo.env.DefineValue(stmt.Vars[0], iv)
+
+				runInfo.stmt = stmt.Stmt
+				runInfo.runSingleStmt()
+				if runInfo.err != nil {
+					if runInfo.err == ErrContinue {
+						runInfo.err = nil
+						continue
+					}
+					if runInfo.err == ErrReturn {
+						runInfo.env = env
+						return
+					}
+					if runInfo.err == ErrBreak {
+						runInfo.err = nil
+					}
+					break
+				}
+			}
+			runInfo.rv = nilValue
+			runInfo.env = env
+			return
+		}
+
 		switch value.Kind() {
 		case reflect.Slice, reflect.Array:
 			for i := 0; i < value.Len(); i++ {
@@ -602,6 +669,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			return
 		}
 		runInfo.err = newStringError(stmt, fmt.Sprint(runInfo.rv.Interface()))
+		if runInfo.options.Tracer != nil {
+			runInfo.options.Tracer.Trace(runInfo.ctx, &TraceEvent{Kind: TraceThrow, Pos: stmt.Position(), Value: traceValue(runInfo.rv), Err: runInfo.err})
+		}
 
 	// ModuleStmt
 	case *ast.ModuleStmt:
@@ -631,7 +701,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		}
 		value := runInfo.rv
 
-		for _, switchCaseStmt := range stmt.Cases {
+		for i, switchCaseStmt := range stmt.Cases {
 			caseStmt := switchCaseStmt.(*ast.SwitchCaseStmt)
 			for _, runInfo.expr = range caseStmt.Exprs {
 				runInfo.invokeExpr()
@@ -640,6 +710,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 					return
 				}
 				if equal(runInfo.rv, value) {
+					if runInfo.options.Coverage != nil {
+						runInfo.options.Coverage.countBranch(stmt, i)
+					}
 					runInfo.stmt = caseStmt.Stmt
 					runInfo.runSingleStmt()
 					runInfo.env = env
@@ -648,6 +721,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			}
 		}
 
+		if runInfo.options.Coverage != nil {
+			runInfo.options.Coverage.countBranch(stmt, len(stmt.Cases))
+		}
 		if stmt.Default == nil {
 			runInfo.rv = nilValue
 		} else {
@@ -686,11 +762,11 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		switch item.Kind() {
 		case reflect.String:
 			if stmt.Key != nil && runInfo.rv.Kind() == reflect.Bool && runInfo.rv.Bool() {
-				runInfo.env.DeleteGlobal(item.String())
+				runInfo.err = newError(stmt, runInfo.env.DeleteGlobalChecked(item.String()))
 				runInfo.rv = nilValue
 				return
 			}
-			runInfo.env.Delete(item.String())
+			runInfo.err = newError(stmt, runInfo.env.DeleteChecked(item.String()))
 			runInfo.rv = nilValue
 
 		case reflect.Map:
@@ -703,7 +779,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				runInfo.rv = nilValue
 				return
 			}
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, item.Type().Key())
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, item.Type().Key())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(stmt, "cannot use type "+item.Type().Key().String()+" as type "+runInfo.rv.Type().String()+" in delete")
 				runInfo.rv = nilValue
@@ -801,3 +877,62 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 	}
 
 }
+
+// defineNames evaluates exprs and defines the values to names in current scope with defineFunc
+func (runInfo *runInfoStruct) defineNames(stmt ast.Stmt, names []string, exprs []ast.Expr, defineFunc func(string, reflect.Value) error) {
+	// get right side expression values
+	rvs := make([]reflect.Value, len(exprs))
+	var i int
+	for i, runInfo.expr = range exprs {
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		if env, ok := runInfo.rv.Interface().(*env.Env); ok {
+			rvs[i] = reflect.ValueOf(env.DeepCopy())
+		} else {
+			rvs[i] = runInfo.rv
+		}
+	}
+
+	if len(rvs) == 1 && len(names) > 1 {
+		// only one right side value but many left side names
+		value := rvs[0]
+		if value.Kind() == reflect.Interface && !value.IsNil() {
+			value = value.Elem()
+		}
+		if (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) && value.Len() > 0 {
+			// value is slice/array, add each value to left side names
+			for i := 0; i < value.Len() && i < len(names); i++ {
+				runInfo.err = defineFunc(names[i], value.Index(i))
+				if runInfo.err != nil {
+					runInfo.err = newError(stmt, runInfo.err)
+					runInfo.rv = nilValue
+					return
+				}
+				if runInfo.options.Tracer != nil {
+					runInfo.traceVarWrite(stmt, runInfo.env, names[i], value.Index(i))
+				}
+			}
+			// return last value of slice/array
+			runInfo.rv = value.Index(value.Len() - 1)
+			return
+		}
+	}
+
+	// define all names with right side values
+	for i = 0; i < len(rvs) && i < len(names); i++ {
+		runInfo.err = defineFunc(names[i], rvs[i])
+		if runInfo.err != nil {
+			runInfo.err = newError(stmt, runInfo.err)
+			runInfo.rv = nilValue
+			return
+		}
+		if runInfo.options.Tracer != nil {
+			runInfo.traceVarWrite(stmt, runInfo.env, names[i], rvs[i])
+		}
+	}
+
+	// return last right side value
+	runInfo.rv = rvs[len(rvs)-1]
+}
@@ -0,0 +1,154 @@
+package vm
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+)
+
+// TraceKind is the kind of a trace event
+type TraceKind string
+
+const (
+	// TraceStmtEnter is sent before a statement runs
+	TraceStmtEnter TraceKind = "stmt_enter"
+	// TraceStmtExit is sent after a statement ran, with the error of the statement if any
+	TraceStmtExit TraceKind = "stmt_exit"
+	// TraceGoCall is sent after a call into a Go function, with the arguments and results.
+	// For a go call it is sent when the goroutine is started, without results.
+	TraceGoCall TraceKind = "go_call"
+	// TraceScriptCall is sent when a script function is called, with the arguments
+	TraceScriptCall TraceKind = "script_call"
+	// TraceScriptReturn is sent when a script function returns, with the result and error
+	TraceScriptReturn TraceKind = "script_return"
+	// TraceVarWrite is sent after a variable is defined or set, with the name, scope and value
+	TraceVarWrite TraceKind = "var_write"
+	// TraceThrow is sent when a throw statement throws, with the thrown value and error
+	TraceThrow TraceKind = "throw"
+)
+
+// TraceEvent is an event of a run sent to a Tracer
+type TraceEvent struct {
+	Kind TraceKind
+	Pos  ast.Position
+	// Stmt is the statement of statement events
+	Stmt ast.Stmt
+	// Name is the function name of call events and the variable name of variable writes
+	Name string
+	// Scope is the scope of variable writes: local, outer, global or module
+	Scope string
+	// Args are the arguments of call events, without the context added to a Go call that omits it
+	Args    []interface{}
+	Results []interface{}
+	// Value is the value of variable writes and throws
+	Value interface{}
+	Err   error
+}
+
+// Tracer receives the events of a run.
+// Trace is called from the goroutines running the script, so it must be safe for concurrent use.
+// The event is only valid during the call.
+type Tracer interface {
+	Trace(ctx context.Context, event *TraceEvent)
+}
+
+// traceStmtEnter sends the enter event of stmt and returns a function to send the exit event
+func (runInfo *runInfoStruct) traceStmtEnter(stmt ast.Stmt) func() {
+	runInfo.options.Tracer.Trace(runInfo.ctx, &TraceEvent{Kind: TraceStmtEnter, Pos: stmt.Position(), Stmt: stmt})
+	return func() {
+		event := &TraceEvent{Kind: TraceStmtExit, Pos: stmt.Position(), Stmt: stmt}
+		if runInfo.err != ErrReturn && runInfo.err != ErrBreak && runInfo.err != ErrContinue {
+			event.Err = runInfo.err
+		}
+		runInfo.options.Tracer.Trace(runInfo.ctx, event)
+	}
+}
+
+// traceGoCall sends the Go call event of callExpr
+func (runInfo *runInfoStruct) traceGoCall(callExpr *ast.CallExpr, f reflect.Value, args []reflect.Value, rvs []reflect.Value) {
+	name := callExpr.Name
+	if name == "" {
+		if fn := runtime.FuncForPC(f.Pointer()); fn != nil {
+			name = fn.Name()
+		}
+	}
+	runInfo.options.Tracer.Trace(runInfo.ctx, &TraceEvent{
+		Kind:    TraceGoCall,
+		Pos:     callExpr.Position(),
+		Name:    name,
+		Args:    traceValues(args),
+		Results: traceValues(rvs),
+		Err:     runInfo.err,
+	})
+}
+
+// traceVarWrite sends the variable write event of symbol in e
+func (runInfo *runInfoStruct) traceVarWrite(pos ast.Pos, e *env.Env, symbol string, value reflect.Value) {
+	runInfo.options.Tracer.Trace(runInfo.ctx, &TraceEvent{
+		Kind:  TraceVarWrite,
+		Pos:   pos.Position(),
+		Name:  symbol,
+		Scope: traceScope(e, symbol),
+		Value: traceValue(value),
+	})
+}
+
+// traceScope returns the scope where symbol is found from e: local for the current scope,
+// global for the top scope and outer for the scopes in between
+func traceScope(e *env.Env, symbol string) string {
+	scopeDepth := e.ScopeDepth(symbol)
+	if scopeDepth < 0 {
+		return ""
+	}
+	for i := 0; i < scopeDepth; i++ {
+		e = e.Parent()
+	}
+	switch {
+	case e.Parent() == nil:
+		return "global"
+	case scopeDepth == 0:
+		return "local"
+	}
+	return "outer"
+}
+
+// traceCallName returns the name of the called expression for trace events, like strings.ToUpper
+func traceCallName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.IdentExpr:
+		return expr.Lit
+	case *ast.MemberExpr:
+		name := traceCallName(expr.Expr)
+		if name == "" {
+			return expr.Name
+		}
+		return name + "." + expr.Name
+	}
+	return ""
+}
+
+// traceValues returns the interfaces of values
+func traceValues(values []reflect.Value) []interface{} {
+	if values == nil {
+		return nil
+	}
+	interfaces := make([]interface{}, len(values))
+	for i, value := range values {
+		interfaces[i] = traceValue(value)
+	}
+	return interfaces
+}
+
+// traceValue returns the interface of value, unwrapping the reflect.Value that runVMFunction uses
+func traceValue(value reflect.Value) interface{} {
+	if !value.IsValid() || !value.CanInterface() {
+		return nil
+	}
+	if value.Type() == reflectValueType {
+		return traceValue(value.Interface().(reflect.Value))
+	}
+	return value.Interface()
+}
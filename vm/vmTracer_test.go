@@ -0,0 +1,121 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+)
+
+type testTracer struct {
+	mutex  sync.Mutex
+	events []string
+}
+
+func (tracer *testTracer) Trace(ctx context.Context, event *TraceEvent) {
+	var text string
+	switch event.Kind {
+	case TraceStmtEnter, TraceStmtExit:
+		text = fmt.Sprintf("%v %v:%v %T", event.Kind, event.Pos.Line, event.Pos.Column, event.Stmt)
+		if event.Err != nil {
+			text += " " + event.Err.Error()
+		}
+	case TraceGoCall, TraceScriptCall, TraceScriptReturn:
+		text = fmt.Sprintf("%v %v %v %v %v", event.Kind, event.Name, event.Args, event.Results, event.Err)
+	case TraceVarWrite:
+		text = fmt.Sprintf("%v %v %v %v", event.Kind, event.Name, event.Scope, event.Value)
+	case TraceThrow:
+		text = fmt.Sprintf("%v %v:%v %v %v", event.Kind, event.Pos.Line, event.Pos.Column, event.Value, event.Err)
+	}
+	tracer.mutex.Lock()
+	tracer.events = append(tracer.events, text)
+	tracer.mutex.Unlock()
+}
+
+func TestTracer(t *testing.T) {
+	t.Parallel()
+
+	script := `
+var g = 1
+func f(a) {
+	b = upper(a)
+	g = 2
+	return b
+}
+f("x")
+try {
+	throw "oops"
+} catch {
+}
+`
+	stmt, err := parser.ParseSrc(script)
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	e := env.NewEnv()
+	e.Define("upper", strings.ToUpper)
+	tracer := &testTracer{}
+	_, err = RunContext(context.Background(), e, &Options{Tracer: tracer}, stmt)
+	if err != nil {
+		t.Fatal("RunContext error:", err)
+	}
+
+	expected := []string{
+		"stmt_enter 2:1 *ast.VarStmt",
+		"var_write g global 1",
+		"stmt_exit 2:1 *ast.VarStmt",
+		"stmt_enter 3:1 *ast.ExprStmt",
+		"stmt_exit 3:1 *ast.ExprStmt",
+		"stmt_enter 8:1 *ast.ExprStmt",
+		"script_call f [x] [] <nil>",
+		"stmt_enter 4:2 *ast.LetsStmt",
+		"go_call upper [x] [X] <nil>",
+		"var_write b local X",
+		"stmt_exit 4:2 *ast.LetsStmt",
+		"stmt_enter 5:2 *ast.LetsStmt",
+		"var_write g global 2",
+		"stmt_exit 5:2 *ast.LetsStmt",
+		"stmt_enter 6:2 *ast.ReturnStmt",
+		"stmt_exit 6:2 *ast.ReturnStmt",
+		"script_return f [] [X] <nil>",
+		"stmt_exit 8:1 *ast.ExprStmt",
+		"stmt_enter 9:1 *ast.TryStmt",
+		"stmt_enter 10:2 *ast.ThrowStmt",
+		"throw 10:2 oops oops",
+		"stmt_exit 10:2 *ast.ThrowStmt oops",
+		"stmt_exit 9:1 *ast.TryStmt",
+	}
+	if !reflect.DeepEqual(tracer.events, expected) {
+		t.Errorf("events - received:\n%v\nexpected:\n%v", strings.Join(tracer.events, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestTracerGoCallPanic(t *testing.T) {
+	t.Parallel()
+
+	stmt, err := parser.ParseSrc(`m.Panic(1)`)
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	e := env.NewEnv()
+	e.Define("m", map[string]interface{}{"Panic": func(i int64) int64 { panic("bad value") }})
+	tracer := &testTracer{}
+	_, err = RunContext(context.Background(), e, &Options{Tracer: tracer}, stmt)
+	if err == nil || err.Error() != "bad value" {
+		t.Fatalf("RunContext error - received: %v - expected: %v", err, "bad value")
+	}
+
+	expected := []string{
+		"stmt_enter 1:1 *ast.ExprStmt",
+		"go_call m.Panic [1] [] bad value",
+		"stmt_exit 1:1 *ast.ExprStmt bad value",
+	}
+	if !reflect.DeepEqual(tracer.events, expected) {
+		t.Errorf("events - received:\n%v\nexpected:\n%v", strings.Join(tracer.events, "\n"), strings.Join(expected, "\n"))
+	}
+}
@@ -772,6 +772,92 @@ func TestVMDelete(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestConst(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `const a = 1++`, RunError: fmt.Errorf("invalid operation")},
+		{Script: `const a.b = 1`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `const a = 1`, RunOutput: int64(1), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a, b = 1, 2`, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(1), "b": int64(2)}},
+		{Script: `const a, b = [1, 2]`, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(1), "b": int64(2)}},
+
+		{Script: `const a = 1; a = 2`, RunError: fmt.Errorf("cannot modify constant"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; a++`, RunError: fmt.Errorf("cannot modify constant"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; a += 1`, RunError: fmt.Errorf("cannot modify constant"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; var a = 2`, RunError: fmt.Errorf("cannot modify constant"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; const a = 2`, RunError: fmt.Errorf("cannot modify constant"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; func a() {}`, RunError: fmt.Errorf("cannot modify constant"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; delete("a")`, RunError: fmt.Errorf("cannot modify constant"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; func b() { a = 2 }; b()`, RunError: fmt.Errorf("cannot modify constant"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; func b() { delete("a", true) }; b()`, RunError: fmt.Errorf("cannot modify constant"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `module b { const c = 1 }; b.c = 2`, RunError: fmt.Errorf("cannot modify constant")},
+
+		// constant can be shadowed in child scope
+		{Script: `const a = 1; func b() { var a = 2; return a }; b()`, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(1)}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	envConst := env.NewEnv()
+	err := envConst.DefineConst("a", int64(1))
+	if err != nil {
+		t.Fatal("DefineConst error:", err)
+	}
+	_, err = Execute(envConst, nil, "b = 1\na = 2")
+	if err == nil {
+		t.Fatal("Execute error - received: nil - expected: cannot modify constant")
+	}
+	vmError, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Execute error type - received: %T - expected: *Error", err)
+	}
+	if vmError.Message != "cannot modify constant" || vmError.Pos.Line != 2 || vmError.Pos.Column != 1 {
+		t.Errorf("Execute error - received: %v at %v - expected: %v at 2:1", vmError.Message, vmError.Pos, "cannot modify constant")
+	}
+}
+
+func TestFrozenEnv(t *testing.T) {
+	t.Parallel()
+
+	envSetupFunc := func(t *testing.T, env *env.Env) {
+		env.Define("a", int64(1))
+		env.Define("println", fmt.Println)
+		env.Freeze()
+	}
+
+	tests := []Test{
+		{Script: `a`, RunOutput: int64(1)},
+		{Script: `a = 2`, RunError: fmt.Errorf("cannot modify frozen scope")},
+		{Script: `b = 2`, RunError: fmt.Errorf("cannot modify frozen scope")},
+		{Script: `var b = 2`, RunError: fmt.Errorf("cannot modify frozen scope")},
+		{Script: `const b = 2`, RunError: fmt.Errorf("cannot modify frozen scope")},
+		{Script: `func println() {}`, RunError: fmt.Errorf("cannot modify frozen scope")},
+		{Script: `delete("a")`, RunError: fmt.Errorf("cannot modify frozen scope")},
+		{Script: `make(type b, 1)`, RunError: fmt.Errorf("cannot modify frozen scope")},
+		{Script: `func() { a = 2 }()`, RunError: fmt.Errorf("cannot modify frozen scope")},
+		{Script: `func() { delete("a", true) }()`, RunError: fmt.Errorf("cannot modify frozen scope")},
+
+		// child scopes are not frozen
+		{Script: `func() { c = a + 1; return c }()`, RunOutput: int64(2)},
+		{Script: `if true { var c = a + 1; c }`, RunOutput: int64(2)},
+	}
+	runTests(t, tests, &TestOptions{EnvSetupFunc: &envSetupFunc}, &Options{Debug: true})
+
+	envFrozen := env.NewEnv()
+	envFrozen.Define("a", int64(1))
+	envFrozen.Freeze()
+	envScript := envFrozen.NewEnv()
+	_, err := Execute(envScript, nil, "b = a + 1; a = b")
+	if err == nil || err.Error() != "cannot modify frozen scope" {
+		t.Errorf("Execute error - received: %v - expected: %v", err, "cannot modify frozen scope")
+	}
+	value, err := envScript.Get("b")
+	if err != nil || value != int64(2) {
+		t.Errorf("Get value - received: %v - expected: %v", value, int64(2))
+	}
+}
+
 func TestComment(t *testing.T) {
 	t.Parallel()
 